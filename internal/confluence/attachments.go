@@ -0,0 +1,232 @@
+package confluence
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	attachmentBaseUrlFormat      string = "%s/wiki/api/v2/attachments/%s"
+	pageAttachmentsBaseUrlFormat string = "%s/wiki/api/v2/pages/%d/attachments?limit=250"
+	attachmentDownloadUrlFormat  string = "%s/wiki%s"
+	// Uploading attachments is only supported by the v1 API.
+	newAttachmentBaseUrlFormat  string = "%s/wiki/rest/api/content/%d/child/attachment"
+	attachmentDataBaseUrlFormat string = "%s/wiki/rest/api/content/%d/child/attachment/%s/data"
+
+	defaultAttachmentMediaType string = "application/octet-stream"
+)
+
+// AttachmentUpload is the file uploaded by CreateAttachment and
+// UpdateAttachmentData.
+type AttachmentUpload struct {
+	Filename string
+	// MediaType defaults to application/octet-stream.
+	MediaType string
+	Content   []byte
+	// Comment is shown in the attachment history for this version.
+	Comment string
+	// MinorEdit suppresses notifications to the page's watchers.
+	MinorEdit bool
+}
+
+type Attachment struct {
+	// Attachment ids are not numeric, e.g. att123.
+	Id           string               `json:"id"`
+	Title        string               `json:"title"`
+	MediaType    string               `json:"mediaType"`
+	FileSize     int64                `json:"fileSize"`
+	Comment      string               `json:"comment"`
+	PageId       int64                `json:"pageId"`
+	Version      ContentDetailVersion `json:"version"`
+	DownloadLink string               `json:"downloadLink"`
+	// DownloadUrl is the absolute address of DownloadLink.
+	DownloadUrl string `json:"-"`
+}
+
+// attachmentUploadResult is the part of a v1 upload response needed to read
+// the attachment back from the v2 API.
+type attachmentUploadResult struct {
+	Id string `json:"id"`
+}
+
+// GetAttachmentById fetches the current version of an attachment.
+func (c *Client) GetAttachmentById(ctx context.Context, attachmentId string) (Attachment, error) {
+	requestUrl := fmt.Sprintf(attachmentBaseUrlFormat, c.config.baseUrl, attachmentId)
+
+	var attachment Attachment
+
+	if err := c.doJSON(ctx, "GET", requestUrl, nil, &attachment, http.StatusOK); err != nil {
+		return Attachment{}, err
+	}
+
+	attachment.DownloadUrl = fmt.Sprintf(attachmentDownloadUrlFormat, c.config.baseUrl, attachment.DownloadLink)
+
+	return attachment, nil
+}
+
+// GetPageAttachments lists the attachments of a page. When filename is set,
+// only the attachment with that name is returned.
+func (c *Client) GetPageAttachments(ctx context.Context, pageId int64, filename string) ([]Attachment, error) {
+	requestUrl := fmt.Sprintf(pageAttachmentsBaseUrlFormat, c.config.baseUrl, pageId)
+
+	if filename != "" {
+		requestUrl += "&filename=" + url.QueryEscape(filename)
+	}
+
+	attachments, err := getAllResults[Attachment](ctx, c, requestUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range attachments {
+		attachments[i].DownloadUrl = fmt.Sprintf(attachmentDownloadUrlFormat, c.config.baseUrl, attachments[i].DownloadLink)
+	}
+
+	return attachments, nil
+}
+
+// CreateAttachment uploads a new attachment to a page. Confluence refuses the
+// upload when the page already has an attachment with the same filename.
+func (c *Client) CreateAttachment(ctx context.Context, pageId int64, upload AttachmentUpload) (Attachment, error) {
+	requestUrl := fmt.Sprintf(newAttachmentBaseUrlFormat, c.config.baseUrl, pageId)
+
+	var page resultsPage[attachmentUploadResult]
+
+	if err := c.uploadAttachment(ctx, requestUrl, upload, &page); err != nil {
+		return Attachment{}, err
+	}
+
+	if len(page.Results) == 0 {
+		return Attachment{}, &DecodeError{Method: "POST", Url: requestUrl, Err: errors.New("no attachment in upload response")}
+	}
+
+	return c.GetAttachmentById(ctx, page.Results[0].Id)
+}
+
+// UpdateAttachmentData uploads a new version of an existing attachment.
+func (c *Client) UpdateAttachmentData(ctx context.Context, pageId int64, attachmentId string, upload AttachmentUpload) (Attachment, error) {
+	requestUrl := fmt.Sprintf(attachmentDataBaseUrlFormat, c.config.baseUrl, pageId, attachmentId)
+
+	var result attachmentUploadResult
+
+	if err := c.uploadAttachment(ctx, requestUrl, upload, &result); err != nil {
+		return Attachment{}, err
+	}
+
+	return c.GetAttachmentById(ctx, attachmentId)
+}
+
+// DeleteAttachment moves an attachment, with all its versions, to the trash.
+func (c *Client) DeleteAttachment(ctx context.Context, attachmentId string) error {
+	requestUrl := fmt.Sprintf(attachmentBaseUrlFormat, c.config.baseUrl, attachmentId)
+
+	return c.doJSON(ctx, "DELETE", requestUrl, nil, nil, http.StatusNoContent)
+}
+
+// uploadAttachment posts upload as multipart/form-data to requestUrl and
+// decodes the response into responseBody.
+func (c *Client) uploadAttachment(ctx context.Context, requestUrl string, upload AttachmentUpload, responseBody any) error {
+	body, contentType, err := NewAttachmentUploadBody(upload)
+
+	if err != nil {
+		return err
+	}
+
+	// The body is buffered so it can be replayed when the request is retried.
+	req, err := c.newRequest(ctx, "POST", requestUrl, bytes.NewReader(body))
+
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	// Uploads are rejected as a possible XSRF attack without this header.
+	req.Header.Set("X-Atlassian-Token", "no-check")
+
+	resp, err := c.do(req)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if err := checkResponse(resp, http.StatusOK); err != nil {
+		return err
+	}
+
+	responseData, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(responseData, responseBody); err != nil {
+		return &DecodeError{Method: req.Method, Url: requestUrl, Err: err}
+	}
+
+	return nil
+}
+
+// NewAttachmentUploadBody encodes upload as a multipart/form-data body and
+// returns it with its Content-Type.
+func NewAttachmentUploadBody(upload AttachmentUpload) ([]byte, string, error) {
+	var body bytes.Buffer
+
+	writer := multipart.NewWriter(&body)
+
+	mediaType := upload.MediaType
+
+	if mediaType == "" {
+		mediaType = defaultAttachmentMediaType
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, escapeQuotes(upload.Filename)))
+	header.Set("Content-Type", mediaType)
+
+	part, err := writer.CreatePart(header)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	if _, err := part.Write(upload.Content); err != nil {
+		return nil, "", err
+	}
+
+	if upload.Comment != "" {
+		if err := writer.WriteField("comment", upload.Comment); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := writer.WriteField("minorEdit", strconv.FormatBool(upload.MinorEdit)); err != nil {
+		return nil, "", err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return body.Bytes(), writer.FormDataContentType(), nil
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// escapeQuotes escapes a filename for a Content-Disposition header, as
+// mime/multipart does for CreateFormFile.
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
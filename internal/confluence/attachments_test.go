@@ -0,0 +1,92 @@
+package confluence
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestClientCreateAttachment(t *testing.T) {
+	attempts := 0
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost:
+			attempts++
+
+			if r.URL.Path != "/wiki/rest/api/content/5/child/attachment" || r.Header.Get("X-Atlassian-Token") != "no-check" {
+				t.Errorf("unexpected request: %s %s", r.URL.Path, r.Header)
+			}
+
+			// The first attempt is throttled to check the body is replayed.
+			if attempts == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+
+			file, header, err := r.FormFile("file")
+
+			if err != nil {
+				t.Errorf("unable to read file part: %s", err)
+				return
+			}
+
+			content, _ := io.ReadAll(file)
+
+			if header.Filename != `diagram "v2".png` || header.Header.Get("Content-Type") != "image/png" || string(content) != "\x89PNG" {
+				t.Errorf("unexpected file: %q %s %q", header.Filename, header.Header, content)
+			}
+
+			if r.FormValue("comment") != "Regenerated" || r.FormValue("minorEdit") != "true" {
+				t.Errorf("unexpected fields: %v", r.MultipartForm.Value)
+			}
+
+			_, _ = w.Write([]byte(`{"results":[{"id":"att9","title":"diagram.png"}]}`))
+		case r.URL.Path == "/wiki/api/v2/attachments/att9":
+			_, _ = w.Write([]byte(`{"id":"att9","title":"diagram.png","mediaType":"image/png","fileSize":4,"pageId":5,"version":{"number":1}}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}, fastRetry(1))
+
+	attachment, err := client.CreateAttachment(context.Background(), 5, AttachmentUpload{
+		Filename:  `diagram "v2".png`,
+		MediaType: "image/png",
+		Content:   []byte("\x89PNG"),
+		Comment:   "Regenerated",
+		MinorEdit: true,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if attachment.Id != "att9" || attachment.FileSize != 4 || attachment.Version.Number != 1 {
+		t.Errorf("unexpected attachment: %+v", attachment)
+	}
+
+	if attempts != 2 {
+		t.Errorf("got %d upload attempts, want 2", attempts)
+	}
+}
+
+func TestClientGetPageAttachments(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wiki/api/v2/pages/5/attachments" || r.URL.Query().Get("filename") != "diagram.png" {
+			t.Errorf("unexpected request: %s %s", r.URL.Path, r.URL.RawQuery)
+		}
+
+		_, _ = w.Write([]byte(`{"results":[{"id":"att9","title":"diagram.png","downloadLink":"/download/attachments/5/diagram.png?version=2"}]}`))
+	})
+
+	attachments, err := client.GetPageAttachments(context.Background(), 5, "diagram.png")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(attachments) != 1 || attachments[0].DownloadUrl != client.config.baseUrl+"/wiki/download/attachments/5/diagram.png?version=2" {
+		t.Errorf("unexpected attachments: %+v", attachments)
+	}
+}
@@ -0,0 +1,81 @@
+package confluence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+const (
+	contentChildrenBaseUrlFormat string = "%s/wiki/api/v2/pages/%d/children?limit=250"
+)
+
+// ContentChild is a page below another page in the page tree.
+type ContentChild struct {
+	Id      int64  `json:"id"`
+	Status  string `json:"status"`
+	Title   string `json:"title"`
+	SpaceId int64  `json:"spaceId"`
+	// ChildPosition orders the page among its siblings, lowest first.
+	ChildPosition int64 `json:"childPosition"`
+	// ParentContentId is the page directly above this one.
+	ParentContentId int64 `json:"-"`
+	// Depth is 1 for a direct child, 2 for a grandchild and so on.
+	Depth int `json:"-"`
+}
+
+// GetContentChildren lists the direct children of a page ordered by their
+// position.
+func (c *Client) GetContentChildren(ctx context.Context, contentId int64) ([]ContentChild, error) {
+	requestUrl := fmt.Sprintf(contentChildrenBaseUrlFormat, c.config.baseUrl, contentId)
+
+	children, err := getAllResults[ContentChild](ctx, c, requestUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(children, func(i, j int) bool { return children[i].ChildPosition < children[j].ChildPosition })
+
+	for i := range children {
+		children[i].ParentContentId = contentId
+		children[i].Depth = 1
+	}
+
+	return children, nil
+}
+
+// GetContentDescendants lists the pages up to depth levels below a page. Each
+// page is followed by its own descendants, so the result reads like the page
+// tree in the Confluence sidebar.
+func (c *Client) GetContentDescendants(ctx context.Context, contentId int64, depth int) ([]ContentChild, error) {
+	if depth < 1 {
+		return nil, errors.New("depth must be at least 1")
+	}
+
+	children, err := c.GetContentChildren(ctx, contentId)
+
+	if err != nil || depth == 1 {
+		return children, err
+	}
+
+	descendants := make([]ContentChild, 0, len(children))
+
+	for _, child := range children {
+		descendants = append(descendants, child)
+
+		below, err := c.GetContentDescendants(ctx, child.Id, depth-1)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, page := range below {
+			page.Depth += child.Depth
+			descendants = append(descendants, page)
+		}
+	}
+
+	return descendants, nil
+}
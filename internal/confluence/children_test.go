@@ -0,0 +1,39 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClientGetContentDescendants(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wiki/api/v2/pages/1/children":
+			_, _ = w.Write([]byte(`{"results":[{"id":3,"title":"Second","childPosition":20},{"id":2,"title":"First","childPosition":10}]}`))
+		case "/wiki/api/v2/pages/2/children":
+			_, _ = w.Write([]byte(`{"results":[{"id":4,"title":"Nested","childPosition":5}]}`))
+		case "/wiki/api/v2/pages/3/children":
+			_, _ = w.Write([]byte(`{"results":[]}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	descendants, err := client.GetContentDescendants(context.Background(), 1, 2)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := make([]string, 0, len(descendants))
+
+	for _, page := range descendants {
+		got = append(got, fmt.Sprintf("%d<%d@%d", page.Id, page.ParentContentId, page.Depth))
+	}
+
+	if want := "[2<1@1 4<2@2 3<1@1]"; fmt.Sprint(got) != want {
+		t.Errorf("descendants = %v, want %s", got, want)
+	}
+}
@@ -2,25 +2,27 @@ package confluence
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"io/ioutil"
-	"log"
 	"net/http"
-	"os"
+	"net/url"
 	"strings"
 
 	"golang.org/x/net/html"
 )
 
 const (
-	contentDetailBaseUrlFormat string = "%s/wiki/api/v2/pages/%d?body-format=storage"
-	// Delete Version not Support in v1 API yet.
-	contentVersionBaseUrlFormat string = "%s/wiki/rest/api/content/%d/version/1"
-	updateDeleteContentBaseUrl  string = "%s/wiki/api/v2/pages/%d"
-	newContentBaseUrlFormat     string = "%s/wiki/api/v2/pages"
+	contentDetailBaseUrlFormat        string = "%s/wiki/api/v2/pages/%d?body-format=storage"
+	contentVersionDetailBaseUrlFormat string = "%s/wiki/api/v2/pages/%d?body-format=storage&version=%d"
+	updateDeleteContentBaseUrl        string = "%s/wiki/api/v2/pages/%d"
+	newContentBaseUrlFormat           string = "%s/wiki/api/v2/pages"
+	contentByTitleBaseUrlFormat       string = "%s/wiki/api/v2/pages?title=%s&space-id=%d&status=current&body-format=storage&limit=250"
+	// Moving content is only supported by the v1 API.
+	moveContentBaseUrlFormat string = "%s/wiki/rest/api/content/%d/move/%s/%d"
 )
 
 type Config struct {
@@ -33,14 +35,152 @@ func NewConfig(baseUrl string, userName string, apiKey string) *Config {
 	return &Config{baseUrl: baseUrl, userName: userName, apiKey: apiKey}
 }
 
-func CreateNewPage(config Config, parentContentId int64, title string, body string) (ContentDetail, error) {
-	parentContent, err := GetContentDetailById(config, parentContentId)
+// Client is a Confluence Cloud API client. A single Client owns one
+// configured http.Client and is safe to share between resources.
+type Client struct {
+	config     Config
+	auth       string
+	httpClient *http.Client
+	retry      RetryConfig
+}
+
+// ClientOption customises a Client during NewClient.
+type ClientOption func(*Client)
+
+// WithTransport sets the http.RoundTripper used for every request, e.g. to
+// route through a corporate proxy or to a test server.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		c.httpClient.Transport = transport
+	}
+}
+
+// WithHTTPClient replaces the underlying http.Client entirely.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// NewClient builds a Client from config. Without options it uses
+// http.DefaultTransport.
+func NewClient(config *Config, options ...ClientOption) *Client {
+	client := &Client{
+		config:     *config,
+		auth:       basicAuth(config.userName, config.apiKey),
+		httpClient: &http.Client{Transport: http.DefaultTransport},
+		retry:      DefaultRetryConfig(),
+	}
+
+	for _, option := range options {
+		option(client)
+	}
+
+	return client
+}
+
+// newRequest builds an authenticated request against the configured site.
+func (c *Client) newRequest(ctx context.Context, method string, requestUrl string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, requestUrl, body)
 
 	if err != nil {
-		return ContentDetail{}, err
+		return nil, err
+	}
+
+	req.Header.Add("Authorization", "Basic "+c.auth)
+
+	if body != nil {
+		req.Header.Add("Content-Type", "application/json")
+	}
+
+	return req, nil
+}
+
+// doJSON sends requestBody, when not nil, as JSON and decodes the response
+// into responseBody, when not nil. Responses with a status code other than
+// expectedStatusCodes are returned as an *APIError.
+func (c *Client) doJSON(ctx context.Context, method string, requestUrl string, requestBody any, responseBody any, expectedStatusCodes ...int) error {
+	var bodyReader io.Reader
+
+	if requestBody != nil {
+		requestJson, err := json.Marshal(requestBody)
+
+		if err != nil {
+			return err
+		}
+
+		bodyReader = bytes.NewReader(requestJson)
+	}
+
+	req, err := c.newRequest(ctx, method, requestUrl, bodyReader)
+
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
+
+	if err != nil {
+		return err
 	}
 
-	newPageRequest, err := NewNewOperationRequest(title, parentContent.SpaceId, body, parentContentId)
+	defer resp.Body.Close()
+
+	if err := checkResponse(resp, expectedStatusCodes...); err != nil {
+		return err
+	}
+
+	if responseBody == nil {
+		return nil
+	}
+
+	responseData, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(responseData, responseBody); err != nil {
+		return &DecodeError{Method: method, Url: requestUrl, Err: err}
+	}
+
+	return nil
+}
+
+// ContentPlacement selects where CreateNewPage creates a page. Set
+// ParentContentId for a child page, or SpaceId or SpaceKey for a page at the
+// root of a space.
+type ContentPlacement struct {
+	ParentContentId int64
+	SpaceId         int64
+	SpaceKey        string
+}
+
+func (c *Client) CreateNewPage(ctx context.Context, placement ContentPlacement, title string, body string) (ContentDetail, error) {
+	spaceId := placement.SpaceId
+
+	switch {
+	case placement.ParentContentId != 0:
+		parentContent, err := c.GetContentDetailById(ctx, placement.ParentContentId)
+
+		if err != nil {
+			return ContentDetail{}, err
+		}
+
+		spaceId = parentContent.SpaceId
+	case placement.SpaceKey != "":
+		space, err := c.GetSpaceByKey(ctx, placement.SpaceKey)
+
+		if err != nil {
+			return ContentDetail{}, err
+		}
+
+		spaceId = space.Id
+	case spaceId == 0:
+		return ContentDetail{}, errors.New("a parent page or a space is required to create a page")
+	}
+
+	newPageRequest, err := NewNewOperationRequest(title, spaceId, body, placement.ParentContentId)
 
 	if err != nil {
 		return ContentDetail{}, err
@@ -54,35 +194,33 @@ func CreateNewPage(config Config, parentContentId int64, title string, body stri
 
 	bodyReader := bytes.NewReader(newPageRequestJson)
 
-	requestUrl := fmt.Sprintf(newContentBaseUrlFormat, config.baseUrl)
-
-	auth := basicAuth(config.userName, config.apiKey)
+	requestUrl := fmt.Sprintf(newContentBaseUrlFormat, c.config.baseUrl)
 
-	client := &http.Client{}
+	// Without root-level, pages without a parent are created under the
+	// space homepage.
+	if placement.ParentContentId == 0 {
+		requestUrl += "?root-level=true"
+	}
 
-	newReq, err := http.NewRequest("POST", requestUrl, bodyReader)
+	newReq, err := c.newRequest(ctx, "POST", requestUrl, bodyReader)
 
 	if err != nil {
 		return ContentDetail{}, err
 	}
 
-	newReq.Header.Add("Authorization", "Basic "+auth)
-	newReq.Header.Add("Content-Type", "application/json")
-	newResp, err := client.Do(newReq)
+	newResp, err := c.do(newReq)
 
 	if err != nil {
 		return ContentDetail{}, err
 	}
 
-	if newResp.StatusCode != 200 {
-		body, err := ioutil.ReadAll(newResp.Body)
-
-		_ = err
+	defer newResp.Body.Close()
 
-		return ContentDetail{}, fmt.Errorf("Error Updating content: Status: %d, Reason: %s - Body: %s", newResp.StatusCode, newResp.Status, body)
+	if err := checkResponse(newResp, http.StatusOK); err != nil {
+		return ContentDetail{}, err
 	}
 
-	responseData, err := ioutil.ReadAll(newResp.Body)
+	responseData, err := io.ReadAll(newResp.Body)
 
 	if err != nil {
 		return ContentDetail{}, err
@@ -92,10 +230,10 @@ func CreateNewPage(config Config, parentContentId int64, title string, body stri
 	err = json.Unmarshal(responseData, &contentDetail)
 
 	if err != nil {
-		return ContentDetail{}, err
+		return ContentDetail{}, &DecodeError{Method: newReq.Method, Url: requestUrl, Err: err}
 	}
 
-	return GetContentDetailById(config, contentDetail.Id)
+	return c.GetContentDetailById(ctx, contentDetail.Id)
 }
 
 func NewNewOperationRequest(title string, spaceId int64, body string, parentContentId int64) (ContentNewOperationRequest, error) {
@@ -117,26 +255,97 @@ func NewNewOperationRequest(title string, spaceId int64, body string, parentCont
 	return request, nil
 }
 
-func GetContentDetailById(config Config, contentId int64) (ContentDetail, error) {
-	auth := basicAuth(config.userName, config.apiKey)
+func (c *Client) GetContentDetailById(ctx context.Context, contentId int64) (ContentDetail, error) {
+	return c.getContentDetail(ctx, fmt.Sprintf(contentDetailBaseUrlFormat, c.config.baseUrl, contentId))
+}
+
+// GetContentVersionDetailById fetches a page as it was at a previous version.
+func (c *Client) GetContentVersionDetailById(ctx context.Context, contentId int64, version int64) (ContentDetail, error) {
+	return c.getContentDetail(ctx, fmt.Sprintf(contentVersionDetailBaseUrlFormat, c.config.baseUrl, contentId, version))
+}
+
+// ContentLookup selects a page by its title within a space, and optionally
+// under a parent page. Set SpaceId or SpaceKey.
+type ContentLookup struct {
+	Title           string
+	SpaceId         int64
+	SpaceKey        string
+	ParentContentId int64
+}
+
+// GetContentDetailByTitle finds the single current page matching lookup. A
+// *NotFoundError is returned when no page matches and an *AmbiguousError when
+// several do.
+func (c *Client) GetContentDetailByTitle(ctx context.Context, lookup ContentLookup) (ContentDetail, error) {
+	spaceId := lookup.SpaceId
+	query := fmt.Sprintf("title %q in space %d", lookup.Title, spaceId)
+
+	if lookup.SpaceKey != "" {
+		space, err := c.GetSpaceByKey(ctx, lookup.SpaceKey)
+
+		if err != nil {
+			return ContentDetail{}, err
+		}
+
+		spaceId = space.Id
+		query = fmt.Sprintf("title %q in space %s", lookup.Title, lookup.SpaceKey)
+	}
 
-	requestUrl := fmt.Sprintf(contentDetailBaseUrlFormat, config.baseUrl, contentId)
+	if lookup.ParentContentId != 0 {
+		query += fmt.Sprintf(" under page %d", lookup.ParentContentId)
+	}
 
-	client := &http.Client{}
+	requestUrl := fmt.Sprintf(contentByTitleBaseUrlFormat, c.config.baseUrl, url.QueryEscape(lookup.Title), spaceId)
+
+	pages, err := getAllResults[ContentDetail](ctx, c, requestUrl)
 
-	req, err := http.NewRequest("GET", requestUrl, nil)
 	if err != nil {
 		return ContentDetail{}, err
 	}
 
-	req.Header.Add("Authorization", "Basic "+auth)
-	resp, err := client.Do(req)
+	var matches []ContentDetail
+
+	for _, page := range pages {
+		if lookup.ParentContentId == 0 || page.ParentContentId == lookup.ParentContentId {
+			matches = append(matches, page)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return ContentDetail{}, &NotFoundError{Kind: "page", Query: query}
+	case 1:
+		return matches[0], nil
+	}
+
+	ids := make([]int64, 0, len(matches))
 
+	for _, match := range matches {
+		ids = append(ids, match.Id)
+	}
+
+	return ContentDetail{}, &AmbiguousError{Kind: "page", Query: query, Ids: ids}
+}
+
+func (c *Client) getContentDetail(ctx context.Context, requestUrl string) (ContentDetail, error) {
+	req, err := c.newRequest(ctx, "GET", requestUrl, nil)
 	if err != nil {
 		return ContentDetail{}, err
 	}
 
-	responseData, err := ioutil.ReadAll(resp.Body)
+	resp, err := c.do(req)
+
+	if err != nil {
+		return ContentDetail{}, err
+	}
+
+	defer resp.Body.Close()
+
+	if err := checkResponse(resp, http.StatusOK); err != nil {
+		return ContentDetail{}, err
+	}
+
+	responseData, err := io.ReadAll(resp.Body)
 
 	if err != nil {
 		return ContentDetail{}, err
@@ -146,76 +355,89 @@ func GetContentDetailById(config Config, contentId int64) (ContentDetail, error)
 	err = json.Unmarshal(responseData, &contentDetail)
 
 	if err != nil {
-		log.Fatal(err)
-		return ContentDetail{}, err
+		return ContentDetail{}, &DecodeError{Method: req.Method, Url: requestUrl, Err: err}
 	}
 
-	contentDetail.ResponseStatusCode = resp.StatusCode
-	contentDetail.ResponseStatus = resp.Status
+	return contentDetail, nil
+}
 
-	return contentDetail, err
+// ContentUpdate describes a change to an existing page.
+type ContentUpdate struct {
+	// Title renames the page. The current title is kept when empty.
+	Title string
+	Body  string
+	// ExpectedVersion, when set, is the version the caller last saw. The
+	// update is refused with a *VersionConflictError if the page has moved on.
+	ExpectedVersion int64
+	// VersionMessage is shown in the page history for the new version.
+	VersionMessage string
+	// MinorEdit suppresses notifications to the page's watchers.
+	MinorEdit bool
 }
 
-func UpdateContentById(config Config, contentId int64, body string, removePreviousVersions bool) (ContentDetail, error) {
-	contentDetail, err := GetContentDetailById(config, contentId)
+func (c *Client) UpdateContentById(ctx context.Context, contentId int64, update ContentUpdate) (ContentDetail, error) {
+	contentDetail, err := c.GetContentDetailById(ctx, contentId)
 
 	if err != nil {
-		log.Fatal(err)
 		return ContentDetail{}, err
 	}
 
-	updateRequest, err := NewUpdateOperationRequest(contentDetail, body)
+	if update.ExpectedVersion > 0 && contentDetail.Version.Number != update.ExpectedVersion {
+		conflictErr := &VersionConflictError{
+			ContentId:       contentId,
+			ExpectedVersion: update.ExpectedVersion,
+			Current:         contentDetail,
+		}
+
+		// The expected version may already have been pruned.
+		expected, err := c.GetContentVersionDetailById(ctx, contentId, update.ExpectedVersion)
+
+		if err == nil {
+			conflictErr.Expected = &expected
+		}
+
+		return ContentDetail{}, conflictErr
+	}
+
+	updateRequest, err := NewUpdateOperationRequest(contentDetail, update)
 
 	if err != nil {
-		log.Fatal(err)
 		return ContentDetail{}, err
 	}
 
 	updateRequestJson, err := json.Marshal(updateRequest)
 
 	if err != nil {
-		log.Fatal(err)
 		return ContentDetail{}, err
 	}
 
 	bodyReader := bytes.NewReader(updateRequestJson)
 
-	requestUrl := fmt.Sprintf(updateDeleteContentBaseUrl, config.baseUrl, contentId)
-
-	auth := basicAuth(config.userName, config.apiKey)
-
-	client := &http.Client{}
+	requestUrl := fmt.Sprintf(updateDeleteContentBaseUrl, c.config.baseUrl, contentId)
 
-	upReq, err := http.NewRequest("PUT", requestUrl, bodyReader)
+	upReq, err := c.newRequest(ctx, "PUT", requestUrl, bodyReader)
 
 	if err != nil {
 		return ContentDetail{}, err
 	}
 
-	upReq.Header.Add("Authorization", "Basic "+auth)
-	upReq.Header.Add("Content-Type", "application/json")
-	upResp, err := client.Do(upReq)
+	upResp, err := c.do(upReq)
 
 	if err != nil {
 		return ContentDetail{}, err
 	}
 
-	if upResp.StatusCode != 200 {
-		return ContentDetail{}, fmt.Errorf("Error Updating content: Status: %d, Reason: %s", upResp.StatusCode, upResp.Status)
+	if err := checkResponse(upResp, http.StatusOK); err != nil {
+		return ContentDetail{}, err
 	}
 
-	if removePreviousVersions {
-		err = RemovePreviousVersions(config, contentId, 1)
-		if err != nil {
-			log.Fatal(err)
-		}
-	}
+	upResp.Body.Close()
 
-	return GetContentDetailById(config, contentId)
+	return c.GetContentDetailById(ctx, contentId)
 }
 
-func NewUpdateOperationRequest(detail ContentDetail, body string) (ContentUpdateOperationRequest, error) {
-	htmlErr := isValidHTML(body)
+func NewUpdateOperationRequest(detail ContentDetail, update ContentUpdate) (ContentUpdateOperationRequest, error) {
+	htmlErr := isValidHTML(update.Body)
 
 	if htmlErr != nil {
 		return ContentUpdateOperationRequest{}, htmlErr
@@ -228,87 +450,61 @@ func NewUpdateOperationRequest(detail ContentDetail, body string) (ContentUpdate
 	request.Title = detail.Title
 	request.SpaceId = detail.SpaceId
 	request.Body.Storage.Representation = "storage"
-	request.Body.Storage.Value = body
+	request.Body.Storage.Value = update.Body
+
+	if update.Title != "" {
+		request.Title = update.Title
+	}
 	nextVersion := detail.Version.Number + 1
 	request.Version.Number = nextVersion
+	request.Version.Message = update.VersionMessage
+	request.Version.MinorEdit = update.MinorEdit
 
 	return request, nil
 }
 
-func RemovePreviousVersions(config Config, contentId int64, numberOfVersionsToKeep int64) error {
-	if numberOfVersionsToKeep < 1 {
-		fmt.Println("Must keep at least 1 version")
-		os.Exit(1)
-	}
+func (c *Client) DeleteContentById(ctx context.Context, contentId int64) error {
+	requestUrl := fmt.Sprintf(updateDeleteContentBaseUrl, c.config.baseUrl, contentId)
 
-	contentDetail, err := GetContentDetailById(config, contentId)
+	upReq, err := c.newRequest(ctx, "DELETE", requestUrl, nil)
 
 	if err != nil {
-		log.Fatal(err)
 		return err
 	}
 
-	versionsToDelete := contentDetail.Version.Number - numberOfVersionsToKeep
-	deleteRequestUrl := fmt.Sprintf(contentVersionBaseUrlFormat, config.baseUrl, contentId)
-	auth := basicAuth(config.userName, config.apiKey)
-
-	for {
-		if versionsToDelete <= 0 {
-			break
-		}
-
-		fmt.Printf("Deleting version: %d - %s\n", versionsToDelete, deleteRequestUrl)
-		client := &http.Client{}
-
-		deleteReq, err := http.NewRequest("DELETE", deleteRequestUrl, nil)
-
-		if err != nil {
-			log.Fatal(err)
-		}
-
-		deleteReq.Header.Add("Authorization", "Basic "+auth)
-		deleteResponse, err := client.Do(deleteReq)
+	upResp, err := c.do(upReq)
 
-		if err != nil {
-			log.Fatal(err)
-		}
-
-		if deleteResponse.StatusCode != 204 {
-			log.Printf("Unable to delete version. - Code: %d - Reason: %s\n", deleteResponse.StatusCode, deleteResponse.Status)
-		}
+	if err != nil {
+		return err
+	}
 
-		versionsToDelete = versionsToDelete - 1
+	if err := checkResponse(upResp, http.StatusOK, http.StatusNoContent); err != nil {
+		return err
 	}
 
+	upResp.Body.Close()
+
 	return nil
 }
 
-func DeleteContentById(config Config, contentId int64) (http.Response, error) {
-	requestUrl := fmt.Sprintf(updateDeleteContentBaseUrl, config.baseUrl, contentId)
-
-	auth := basicAuth(config.userName, config.apiKey)
-
-	client := &http.Client{}
-
-	upReq, err := http.NewRequest("DELETE", requestUrl, nil)
+// MovePosition places a page relative to the target of a move.
+type MovePosition string
 
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	upReq.Header.Add("Authorization", "Basic "+auth)
-	upReq.Header.Add("Content-Type", "application/json")
-	upResp, err := client.Do(upReq)
-
-	if err != nil {
-		return *upResp, err
-	}
+const (
+	// MovePositionAppend makes the page the last child of the target.
+	MovePositionAppend MovePosition = "append"
+	// MovePositionBefore makes the page the sibling directly before the target.
+	MovePositionBefore MovePosition = "before"
+	// MovePositionAfter makes the page the sibling directly after the target.
+	MovePositionAfter MovePosition = "after"
+)
 
-	if upResp.StatusCode != 200 {
-		return *upResp, fmt.Errorf("Error Deleting content: Status: %d, Reason: %s", upResp.StatusCode, upResp.Status)
-	}
+// MovePage moves a page, with its descendants, relative to targetId. The page
+// keeps its identifier and history.
+func (c *Client) MovePage(ctx context.Context, contentId int64, position MovePosition, targetId int64) error {
+	requestUrl := fmt.Sprintf(moveContentBaseUrlFormat, c.config.baseUrl, contentId, position, targetId)
 
-	return *upResp, nil
+	return c.doJSON(ctx, "PUT", requestUrl, nil, nil, http.StatusOK)
 }
 
 func isValidHTML(htmlStr string) error {
@@ -0,0 +1,244 @@
+package confluence
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type countingTransport struct {
+	requests int
+	next     http.RoundTripper
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return t.next.RoundTrip(req)
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, options ...ClientOption) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return NewClient(NewConfig(server.URL, "user@example.com", "secret"), options...)
+}
+
+func TestClientGetContentDetailById(t *testing.T) {
+	wantAuth := "Basic " + base64.StdEncoding.EncodeToString([]byte("user@example.com:secret"))
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/wiki/api/v2/pages/42" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		if got := r.Header.Get("Authorization"); got != wantAuth {
+			t.Errorf("Authorization = %q, want %q", got, wantAuth)
+		}
+
+		_, _ = w.Write([]byte(`{"id":42,"title":"Runbook","spaceId":7,"version":{"number":3}}`))
+	})
+
+	detail, err := client.GetContentDetailById(context.Background(), 42)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if detail.Id != 42 || detail.Title != "Runbook" || detail.SpaceId != 7 || detail.Version.Number != 3 {
+		t.Errorf("unexpected detail: %+v", detail)
+	}
+}
+
+func TestClientWithTransport(t *testing.T) {
+	transport := &countingTransport{next: http.DefaultTransport}
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":1}`))
+	}, WithTransport(transport))
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetContentDetailById(context.Background(), 1); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if transport.requests != 2 {
+		t.Errorf("transport saw %d requests, want 2", transport.requests)
+	}
+}
+
+func TestClientHonorsContextCancellation(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not reach the server")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := client.GetContentDetailById(ctx, 1); !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want context.Canceled", err)
+	}
+}
+
+func TestClientReturnsDecodeError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html>not json</html>`))
+	})
+
+	_, err := client.GetContentDetailById(context.Background(), 1)
+
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("error = %v, want *DecodeError", err)
+	}
+}
+
+func TestClientUpdateContentByIdDetectsConflict(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected %s request after a conflict", r.Method)
+			return
+		}
+
+		if r.URL.Query().Get("version") == "2" {
+			_, _ = w.Write([]byte(`{"id":1,"title":"Runbook","version":{"number":2},"body":{"storage":{"value":"<p>old</p>"}}}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id":1,"title":"Runbook","version":{"number":3,"authorId":"abc"},"body":{"storage":{"value":"<p>new</p>"}}}`))
+	})
+
+	_, err := client.UpdateContentById(context.Background(), 1, ContentUpdate{Body: "<p>mine</p>", ExpectedVersion: 2})
+
+	var conflictErr *VersionConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Fatalf("error = %v, want *VersionConflictError", err)
+	}
+
+	if conflictErr.Current.Version.Number != 3 || conflictErr.Expected == nil || conflictErr.Expected.Body.Storage.Value != "<p>old</p>" {
+		t.Errorf("unexpected conflict: %+v", conflictErr)
+	}
+}
+
+func TestNewUpdateOperationRequestTitle(t *testing.T) {
+	detail := ContentDetail{Id: 1, Title: "Old", SpaceId: 7, Version: ContentDetailVersion{Number: 4}}
+
+	request, err := NewUpdateOperationRequest(detail, ContentUpdate{Body: "<p>x</p>"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if request.Title != "Old" || request.Version.Number != 5 {
+		t.Errorf("unexpected request: %+v", request)
+	}
+
+	request, err = NewUpdateOperationRequest(detail, ContentUpdate{Title: "New", Body: "<p>x</p>", VersionMessage: "Regenerated", MinorEdit: true})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if request.Title != "New" {
+		t.Errorf("Title = %q, want %q", request.Title, "New")
+	}
+
+	if request.Version.Message != "Regenerated" || !request.Version.MinorEdit {
+		t.Errorf("Version = %+v, want message and minor edit", request.Version)
+	}
+}
+
+func TestClientMovePage(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/wiki/rest/api/content/12/move/after/34" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		_, _ = w.Write([]byte(`{"pageId":"12"}`))
+	})
+
+	if err := client.MovePage(context.Background(), 12, MovePositionAfter, 34); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestClientCreateNewPageAtSpaceRoot(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/wiki/api/v2/spaces":
+			if r.URL.Query().Get("keys") != "ENG" {
+				t.Errorf("unexpected space query: %s", r.URL.RawQuery)
+			}
+
+			_, _ = w.Write([]byte(`{"results":[{"id":7,"key":"ENG"}]}`))
+		case r.Method == http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+
+			if r.URL.Query().Get("root-level") != "true" || strings.Contains(string(body), "parentId") || !strings.Contains(string(body), `"spaceId":7`) {
+				t.Errorf("unexpected create request: %s %s", r.URL.RawQuery, body)
+			}
+
+			_, _ = w.Write([]byte(`{"id":11}`))
+		default:
+			_, _ = w.Write([]byte(`{"id":11,"spaceId":7,"title":"Home"}`))
+		}
+	})
+
+	detail, err := client.CreateNewPage(context.Background(), ContentPlacement{SpaceKey: "ENG"}, "Home", "<p>Home</p>")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if detail.Id != 11 || detail.SpaceId != 7 {
+		t.Errorf("unexpected detail: %+v", detail)
+	}
+}
+
+func TestClientGetSpaceByKeyNotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"results":[]}`))
+	})
+
+	if _, err := client.GetSpaceByKey(context.Background(), "NOPE"); !IsNotFound(err) {
+		t.Errorf("error = %v, want not found", err)
+	}
+}
+
+func TestClientGetContentDetailByTitle(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("title") != "Runbook & FAQ" || r.URL.Query().Get("space-id") != "7" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+
+		_, _ = w.Write([]byte(`{"results":[{"id":11,"title":"Runbook & FAQ","parentId":1},{"id":12,"title":"Runbook & FAQ","parentId":2}]}`))
+	})
+
+	detail, err := client.GetContentDetailByTitle(context.Background(), ContentLookup{Title: "Runbook & FAQ", SpaceId: 7, ParentContentId: 2})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if detail.Id != 12 {
+		t.Errorf("Id = %d, want 12", detail.Id)
+	}
+
+	_, err = client.GetContentDetailByTitle(context.Background(), ContentLookup{Title: "Runbook & FAQ", SpaceId: 7})
+
+	var ambiguousErr *AmbiguousError
+	if !errors.As(err, &ambiguousErr) || len(ambiguousErr.Ids) != 2 {
+		t.Errorf("error = %v, want *AmbiguousError with 2 ids", err)
+	}
+
+	if _, err := client.GetContentDetailByTitle(context.Background(), ContentLookup{Title: "Runbook & FAQ", SpaceId: 7, ParentContentId: 3}); !IsNotFound(err) {
+		t.Errorf("error = %v, want not found", err)
+	}
+}
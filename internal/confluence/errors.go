@@ -0,0 +1,245 @@
+package confluence
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidVersionsToKeep is returned when asked to keep fewer than one
+// version of a page.
+var ErrInvalidVersionsToKeep = errors.New("must keep at least 1 version")
+
+// DecodeError is returned when a Confluence response body cannot be parsed.
+type DecodeError struct {
+	Method string
+	Url    string
+	Err    error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("unable to decode response from %s %s: %s", e.Method, e.Url, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// VersionDeleteError is returned when a previous version of a page could
+// not be removed. The page itself has already been updated.
+type VersionDeleteError struct {
+	ContentId int64
+	Version   int64
+	Err       error
+}
+
+func (e *VersionDeleteError) Error() string {
+	return fmt.Sprintf("unable to delete version %d of content %d: %s", e.Version, e.ContentId, e.Err)
+}
+
+func (e *VersionDeleteError) Unwrap() error {
+	return e.Err
+}
+
+// NotFoundError is returned when a lookup by something other than an id, such
+// as a space key, matches nothing.
+type NotFoundError struct {
+	Kind  string
+	Query string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("no %s found with %s", e.Kind, e.Query)
+}
+
+// AmbiguousError is returned when a lookup that must resolve to a single
+// item, such as a page title, matches several.
+type AmbiguousError struct {
+	Kind  string
+	Query string
+	Ids   []int64
+}
+
+func (e *AmbiguousError) Error() string {
+	ids := make([]string, 0, len(e.Ids))
+
+	for _, id := range e.Ids {
+		ids = append(ids, strconv.FormatInt(id, 10))
+	}
+
+	return fmt.Sprintf("%d %ss found with %s, ids %s", len(e.Ids), e.Kind, e.Query, strings.Join(ids, ", "))
+}
+
+// VersionPruneError is returned when some previous versions of a page could
+// not be removed.
+type VersionPruneError struct {
+	ContentId int64
+	Failed    []*VersionDeleteError
+}
+
+func (e *VersionPruneError) Error() string {
+	messages := make([]string, 0, len(e.Failed))
+
+	for _, failed := range e.Failed {
+		messages = append(messages, failed.Error())
+	}
+
+	return fmt.Sprintf("unable to remove %d previous version(s) of content %d: %s", len(e.Failed), e.ContentId, strings.Join(messages, "; "))
+}
+
+// VersionConflictError is returned when a page was changed by someone else
+// after the caller last saw it.
+type VersionConflictError struct {
+	ContentId       int64
+	ExpectedVersion int64
+	// Current is the page as it is now.
+	Current ContentDetail
+	// Expected is the page at ExpectedVersion, nil when that version is no
+	// longer available.
+	Expected *ContentDetail
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("content %d was modified outside of Terraform: expected version %d, found version %d", e.ContentId, e.ExpectedVersion, e.Current.Version.Number)
+}
+
+// APIError is returned when Confluence responds with an unexpected status
+// code. It carries the parsed Atlassian error payload and the request id so
+// failures can be reported to Atlassian support.
+type APIError struct {
+	Method     string
+	Url        string
+	StatusCode int
+	Status     string
+	RequestId  string
+	Errors     []APIErrorDetail
+	Message    string
+	Body       string
+}
+
+// APIErrorDetail is a single entry of the Atlassian v2 error payload.
+type APIErrorDetail struct {
+	Status int    `json:"status"`
+	Code   string `json:"code"`
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+}
+
+type apiErrorPayload struct {
+	Errors []APIErrorDetail `json:"errors"`
+	// Message is used by the v1 REST API.
+	Message string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s %s: Status: %d, Reason: %s", e.Method, e.Url, e.StatusCode, e.Status)
+
+	for _, detail := range e.Errors {
+		b.WriteString(" - ")
+		b.WriteString(detail.Title)
+
+		if detail.Detail != "" {
+			b.WriteString(": ")
+			b.WriteString(detail.Detail)
+		}
+	}
+
+	if e.Message != "" {
+		b.WriteString(" - ")
+		b.WriteString(e.Message)
+	}
+
+	if len(e.Errors) == 0 && e.Message == "" && e.Body != "" {
+		b.WriteString(" - Body: ")
+		b.WriteString(e.Body)
+	}
+
+	if e.RequestId != "" {
+		fmt.Fprintf(&b, " (Request Id: %s)", e.RequestId)
+	}
+
+	return b.String()
+}
+
+// newAPIError consumes the body of resp.
+func newAPIError(resp *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		RequestId:  resp.Header.Get("X-Request-Id"),
+	}
+
+	if apiErr.RequestId == "" {
+		apiErr.RequestId = resp.Header.Get("atl-traceid")
+	}
+
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.Url = resp.Request.URL.String()
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+
+	if err != nil {
+		return apiErr
+	}
+
+	var payload apiErrorPayload
+	if json.Unmarshal(body, &payload) == nil {
+		apiErr.Errors = payload.Errors
+		apiErr.Message = payload.Message
+	}
+
+	apiErr.Body = strings.TrimSpace(string(body))
+
+	return apiErr
+}
+
+// checkResponse returns an *APIError when resp has none of the expected
+// status codes. The body of a rejected response is consumed and closed.
+func checkResponse(resp *http.Response, expectedStatusCodes ...int) error {
+	for _, statusCode := range expectedStatusCodes {
+		if resp.StatusCode == statusCode {
+			return nil
+		}
+	}
+
+	defer resp.Body.Close()
+
+	return newAPIError(resp)
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var apiErr *APIError
+
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// IsNotFound reports whether err is an *APIError for a 404 response or a
+// *NotFoundError.
+func IsNotFound(err error) bool {
+	var notFoundErr *NotFoundError
+
+	return hasStatusCode(err, http.StatusNotFound) || errors.As(err, &notFoundErr)
+}
+
+// IsForbidden reports whether err is an *APIError for a 403 response.
+func IsForbidden(err error) bool {
+	return hasStatusCode(err, http.StatusForbidden)
+}
+
+// IsConflict reports whether err is an *APIError for a 409 response.
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsRateLimited reports whether err is an *APIError for a 429 response.
+func IsRateLimited(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
@@ -0,0 +1,59 @@
+package confluence
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAPIErrorFromResponse(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[{"status":404,"code":"NOT_FOUND","title":"Not Found","detail":"Page 9 does not exist"}]}`))
+	})
+
+	_, err := client.GetContentDetailById(context.Background(), 9)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("error = %v, want *APIError", err)
+	}
+
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.RequestId != "req-123" || apiErr.Method != http.MethodGet {
+		t.Errorf("unexpected APIError: %+v", apiErr)
+	}
+
+	if len(apiErr.Errors) != 1 || apiErr.Errors[0].Code != "NOT_FOUND" {
+		t.Errorf("Errors = %+v, want one NOT_FOUND entry", apiErr.Errors)
+	}
+
+	if !strings.Contains(err.Error(), "Page 9 does not exist") || !strings.Contains(err.Error(), "req-123") {
+		t.Errorf("Error() = %q, want detail and request id", err.Error())
+	}
+
+	if !IsNotFound(err) || IsForbidden(err) || IsConflict(err) || IsRateLimited(err) {
+		t.Errorf("status helpers disagree with status %d", apiErr.StatusCode)
+	}
+}
+
+func TestAPIErrorTraceIdFallback(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("atl-traceid", "trace-456")
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"statusCode":409,"message":"Version must be incremented"}`))
+	}, fastRetry(0))
+
+	err := client.DeleteContentById(context.Background(), 9)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.RequestId != "trace-456" || apiErr.Message != "Version must be incremented" {
+		t.Fatalf("error = %#v, want *APIError with trace id and message", err)
+	}
+
+	if !IsConflict(err) {
+		t.Errorf("IsConflict(%v) = false, want true", err)
+	}
+}
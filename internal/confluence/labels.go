@@ -0,0 +1,115 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+)
+
+const (
+	contentLabelsBaseUrlFormat string = "%s/wiki/api/v2/pages/%d/labels?prefix=global&limit=250"
+	// Adding and removing labels is only supported by the v1 API.
+	addContentLabelsBaseUrlFormat   string = "%s/wiki/rest/api/content/%d/label"
+	removeContentLabelBaseUrlFormat string = "%s/wiki/rest/api/content/%d/label?name=%s"
+)
+
+// LabelPrefixGlobal is the prefix of the labels shown on a page. Personal and
+// team labels use other prefixes and are left alone.
+const LabelPrefixGlobal string = "global"
+
+type Label struct {
+	Id     int64  `json:"id"`
+	Name   string `json:"name"`
+	Prefix string `json:"prefix"`
+}
+
+type LabelOperationRequest struct {
+	Prefix string `json:"prefix"`
+	Name   string `json:"name"`
+}
+
+// GetContentLabels lists the names of the global labels of a page, sorted.
+func (c *Client) GetContentLabels(ctx context.Context, contentId int64) ([]string, error) {
+	requestUrl := fmt.Sprintf(contentLabelsBaseUrlFormat, c.config.baseUrl, contentId)
+
+	labels, err := getAllResults[Label](ctx, c, requestUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(labels))
+
+	for _, label := range labels {
+		names = append(names, label.Name)
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// AddContentLabels adds global labels to a page. Labels the page already has
+// are left as they are.
+func (c *Client) AddContentLabels(ctx context.Context, contentId int64, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	request := make([]LabelOperationRequest, 0, len(names))
+
+	for _, name := range names {
+		request = append(request, LabelOperationRequest{Prefix: LabelPrefixGlobal, Name: name})
+	}
+
+	requestUrl := fmt.Sprintf(addContentLabelsBaseUrlFormat, c.config.baseUrl, contentId)
+
+	return c.doJSON(ctx, "POST", requestUrl, request, nil, http.StatusOK)
+}
+
+// RemoveContentLabel removes a global label from a page.
+func (c *Client) RemoveContentLabel(ctx context.Context, contentId int64, name string) error {
+	requestUrl := fmt.Sprintf(removeContentLabelBaseUrlFormat, c.config.baseUrl, contentId, url.QueryEscape(name))
+
+	return c.doJSON(ctx, "DELETE", requestUrl, nil, nil, http.StatusNoContent)
+}
+
+// SetContentLabels reconciles the global labels of a page with names, adding
+// the missing labels and removing the extra ones.
+func (c *Client) SetContentLabels(ctx context.Context, contentId int64, names []string) error {
+	current, err := c.GetContentLabels(ctx, contentId)
+
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[string]bool, len(names))
+
+	for _, name := range names {
+		wanted[name] = true
+	}
+
+	var missing []string
+
+	for _, name := range current {
+		if wanted[name] {
+			delete(wanted, name)
+			continue
+		}
+
+		if err := c.RemoveContentLabel(ctx, contentId, name); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range names {
+		if wanted[name] {
+			missing = append(missing, name)
+			delete(wanted, name)
+		}
+	}
+
+	return c.AddContentLabels(ctx, contentId, missing)
+}
@@ -0,0 +1,46 @@
+package confluence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClientSetContentLabels(t *testing.T) {
+	var added []LabelOperationRequest
+	var removed []string
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Path != "/wiki/api/v2/pages/5/labels" || r.URL.Query().Get("prefix") != LabelPrefixGlobal {
+				t.Errorf("unexpected request: %s %s", r.URL.Path, r.URL.RawQuery)
+			}
+
+			_, _ = w.Write([]byte(`{"results":[{"id":1,"name":"stale","prefix":"global"},{"id":2,"name":"runbook","prefix":"global"}]}`))
+		case http.MethodPost:
+			if err := json.NewDecoder(r.Body).Decode(&added); err != nil {
+				t.Errorf("unable to decode request: %s", err)
+			}
+
+			_, _ = w.Write([]byte(`{"results":[]}`))
+		case http.MethodDelete:
+			removed = append(removed, r.URL.Query().Get("name"))
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	if err := client.SetContentLabels(context.Background(), 5, []string{"runbook", "generated", "generated"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if fmt.Sprint(removed) != "[stale]" {
+		t.Errorf("removed = %v, want [stale]", removed)
+	}
+
+	if len(added) != 1 || added[0].Name != "generated" || added[0].Prefix != LabelPrefixGlobal {
+		t.Errorf("added = %+v, want the generated label", added)
+	}
+}
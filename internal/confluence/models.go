@@ -3,21 +3,21 @@ package confluence
 import "time"
 
 type ContentDetail struct {
-	Id                 int64                `json:"id"`
-	Title              string               `json:"title"`
-	Version            ContentDetailVersion `json:"version"`
-	SpaceId            int64                `json:"spaceId"`
-	CreatedAt          time.Time            `json:"createdAt"`
-	Body               ContentOperationBody `json:"body"`
-	ParentContentId    int64                `json:"parentId"`
-	ResponseStatusCode int
-	ResponseStatus     string
-	ResponseBody       string
+	Id              int64                `json:"id"`
+	Title           string               `json:"title"`
+	Version         ContentDetailVersion `json:"version"`
+	SpaceId         int64                `json:"spaceId"`
+	CreatedAt       time.Time            `json:"createdAt"`
+	Body            ContentOperationBody `json:"body"`
+	ParentContentId int64                `json:"parentId"`
 }
 
 type ContentDetailVersion struct {
 	Number    int64     `json:"number"`
 	CreatedAt time.Time `json:"createdAt"`
+	AuthorId  string    `json:"authorId"`
+	Message   string    `json:"message"`
+	MinorEdit bool      `json:"minorEdit"`
 }
 
 type ContentUpdateOperationRequest struct {
@@ -35,11 +35,13 @@ type ContentNewOperationRequest struct {
 	SpaceId         int64                   `json:"spaceId"`
 	Body            ContentOperationBody    `json:"body"`
 	Version         ContentOperationVersion `json:"version"`
-	ParentContentId int64                   `json:"parentId"`
+	ParentContentId int64                   `json:"parentId,omitempty"`
 }
 
 type ContentOperationVersion struct {
-	Number int64 `json:"number"`
+	Number    int64  `json:"number"`
+	Message   string `json:"message,omitempty"`
+	MinorEdit bool   `json:"minorEdit,omitempty"`
 }
 
 type ContentOperationBody struct {
@@ -50,3 +52,30 @@ type ContentOperationBodyStorage struct {
 	Value          string `json:"value"`
 	Representation string `json:"representation"`
 }
+
+type Space struct {
+	Id          int64            `json:"id"`
+	Key         string           `json:"key"`
+	Name        string           `json:"name"`
+	Type        string           `json:"type"`
+	Status      string           `json:"status"`
+	HomepageId  int64            `json:"homepageId"`
+	Description SpaceDescription `json:"description"`
+}
+
+type SpaceDescription struct {
+	Plain ContentOperationBodyStorage `json:"plain"`
+}
+
+type SpaceOperationRequest struct {
+	Key         string           `json:"key,omitempty"`
+	Name        string           `json:"name"`
+	Type        string           `json:"type,omitempty"`
+	Description SpaceDescription `json:"description"`
+	Homepage    *SpaceHomepage   `json:"homepage,omitempty"`
+}
+
+// SpaceHomepage references a page by id; the v1 API encodes ids as strings.
+type SpaceHomepage struct {
+	Id int64 `json:"id,string"`
+}
@@ -0,0 +1,41 @@
+package confluence
+
+import (
+	"context"
+	"net/http"
+)
+
+// resultsPage is the envelope of a cursor paginated API response.
+type resultsPage[T any] struct {
+	Results []T `json:"results"`
+	Links   struct {
+		Next string `json:"next"`
+		// Context is the path of the site, e.g. /wiki, that v1 next links
+		// are relative to. v2 next links already include it.
+		Context string `json:"context"`
+	} `json:"_links"`
+}
+
+// getAllResults follows the next links of a cursor paginated endpoint,
+// starting at requestUrl, and returns every result.
+func getAllResults[T any](ctx context.Context, c *Client, requestUrl string) ([]T, error) {
+	var results []T
+
+	for requestUrl != "" {
+		var page resultsPage[T]
+
+		if err := c.doJSON(ctx, "GET", requestUrl, nil, &page, http.StatusOK); err != nil {
+			return nil, err
+		}
+
+		results = append(results, page.Results...)
+		requestUrl = ""
+
+		if page.Links.Next != "" {
+			// Next links are relative to the site, e.g. /wiki/api/v2/...
+			requestUrl = c.config.baseUrl + page.Links.Context + page.Links.Next
+		}
+	}
+
+	return results, nil
+}
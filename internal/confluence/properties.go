@@ -0,0 +1,109 @@
+package confluence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+const (
+	contentPropertiesBaseUrlFormat  string = "%s/wiki/api/v2/pages/%d/properties?limit=250"
+	newContentPropertyBaseUrlFormat string = "%s/wiki/api/v2/pages/%d/properties"
+	contentPropertyBaseUrlFormat    string = "%s/wiki/api/v2/pages/%d/properties/%d"
+)
+
+// ContentProperty is a JSON value stored on a page under a key.
+type ContentProperty struct {
+	Id      int64                `json:"id"`
+	Key     string               `json:"key"`
+	Value   json.RawMessage      `json:"value"`
+	Version ContentDetailVersion `json:"version"`
+}
+
+type ContentPropertyOperationRequest struct {
+	Key     string                   `json:"key"`
+	Value   json.RawMessage          `json:"value"`
+	Version *ContentOperationVersion `json:"version,omitempty"`
+}
+
+// GetContentProperties lists every property of a page, including those set
+// by Confluence itself, sorted by key.
+func (c *Client) GetContentProperties(ctx context.Context, contentId int64) ([]ContentProperty, error) {
+	requestUrl := fmt.Sprintf(contentPropertiesBaseUrlFormat, c.config.baseUrl, contentId)
+
+	properties, err := getAllResults[ContentProperty](ctx, c, requestUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(properties, func(i, j int) bool { return properties[i].Key < properties[j].Key })
+
+	return properties, nil
+}
+
+// SetContentProperties creates or updates the properties in set and deletes
+// the properties named in remove. Other properties of the page are left
+// alone.
+func (c *Client) SetContentProperties(ctx context.Context, contentId int64, set map[string]json.RawMessage, remove []string) error {
+	properties, err := c.GetContentProperties(ctx, contentId)
+
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]ContentProperty, len(properties))
+
+	for _, property := range properties {
+		current[property.Key] = property
+	}
+
+	keys := make([]string, 0, len(set))
+
+	for key := range set {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		request := ContentPropertyOperationRequest{Key: key, Value: set[key]}
+
+		existing, ok := current[key]
+
+		if !ok {
+			requestUrl := fmt.Sprintf(newContentPropertyBaseUrlFormat, c.config.baseUrl, contentId)
+
+			if err := c.doJSON(ctx, "POST", requestUrl, request, nil, http.StatusOK); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		request.Version = &ContentOperationVersion{Number: existing.Version.Number + 1}
+		requestUrl := fmt.Sprintf(contentPropertyBaseUrlFormat, c.config.baseUrl, contentId, existing.Id)
+
+		if err := c.doJSON(ctx, "PUT", requestUrl, request, nil, http.StatusOK); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range remove {
+		existing, ok := current[key]
+
+		if !ok {
+			continue
+		}
+
+		requestUrl := fmt.Sprintf(contentPropertyBaseUrlFormat, c.config.baseUrl, contentId, existing.Id)
+
+		if err := c.doJSON(ctx, "DELETE", requestUrl, nil, nil, http.StatusNoContent); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package confluence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClientSetContentProperties(t *testing.T) {
+	var calls []string
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`{"results":[
+				{"id":1,"key":"generator","value":{"version":"1.0"},"version":{"number":3}},
+				{"id":2,"key":"stale","value":true,"version":{"number":1}},
+				{"id":3,"key":"editor","value":"v2","version":{"number":1}}
+			]}`))
+			return
+		}
+
+		if r.Method == http.MethodDelete {
+			calls = append(calls, r.Method+" "+r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		var request ContentPropertyOperationRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Errorf("unable to decode request: %s", err)
+		}
+
+		call := fmt.Sprintf("%s %s %s %s", r.Method, r.URL.Path, request.Key, request.Value)
+
+		if request.Version != nil {
+			call += fmt.Sprintf(" v%d", request.Version.Number)
+		}
+
+		calls = append(calls, call)
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	set := map[string]json.RawMessage{
+		"generator": json.RawMessage(`{"version":"2.0"}`),
+		"source":    json.RawMessage(`"github.com/example/runbooks"`),
+	}
+
+	if err := client.SetContentProperties(context.Background(), 5, set, []string{"stale", "missing"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []string{
+		`PUT /wiki/api/v2/pages/5/properties/1 generator {"version":"2.0"} v4`,
+		`POST /wiki/api/v2/pages/5/properties source "github.com/example/runbooks"`,
+		`DELETE /wiki/api/v2/pages/5/properties/2`,
+	}
+
+	if fmt.Sprintf("%q", calls) != fmt.Sprintf("%q", want) {
+		t.Errorf("calls = %q, want %q", calls, want)
+	}
+}
@@ -0,0 +1,139 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+const (
+	// Restrictions are only supported by the v1 API.
+	contentRestrictionsByOperationBaseUrlFormat string = "%s/wiki/rest/api/content/%d/restriction/byOperation?expand=restrictions.user,restrictions.group"
+	contentRestrictionsBaseUrlFormat            string = "%s/wiki/rest/api/content/%d/restriction"
+)
+
+const (
+	RestrictionOperationRead   string = "read"
+	RestrictionOperationUpdate string = "update"
+)
+
+// ContentRestrictions lists who may view and edit a page. A page without
+// restrictions for an operation is open to everyone with access to its space.
+type ContentRestrictions struct {
+	Read   RestrictionSubjects
+	Update RestrictionSubjects
+}
+
+// RestrictionSubjects are the users, by account id, and groups, by name,
+// allowed to perform an operation.
+type RestrictionSubjects struct {
+	Users  []string
+	Groups []string
+}
+
+type contentRestrictionsByOperation map[string]struct {
+	Restrictions struct {
+		User struct {
+			Results []struct {
+				AccountId string `json:"accountId"`
+			} `json:"results"`
+		} `json:"user"`
+		Group struct {
+			Results []struct {
+				Name string `json:"name"`
+			} `json:"results"`
+		} `json:"group"`
+	} `json:"restrictions"`
+}
+
+type ContentRestrictionOperationRequest struct {
+	Operation    string                            `json:"operation"`
+	Restrictions ContentRestrictionSubjectsRequest `json:"restrictions"`
+}
+
+type ContentRestrictionSubjectsRequest struct {
+	User  []ContentRestrictionUser  `json:"user"`
+	Group []ContentRestrictionGroup `json:"group"`
+}
+
+type ContentRestrictionUser struct {
+	Type      string `json:"type"`
+	AccountId string `json:"accountId"`
+}
+
+type ContentRestrictionGroup struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+// GetContentRestrictions fetches the read and update restrictions of a page,
+// with users and groups sorted.
+func (c *Client) GetContentRestrictions(ctx context.Context, contentId int64) (ContentRestrictions, error) {
+	requestUrl := fmt.Sprintf(contentRestrictionsByOperationBaseUrlFormat, c.config.baseUrl, contentId)
+
+	var byOperation contentRestrictionsByOperation
+
+	if err := c.doJSON(ctx, "GET", requestUrl, nil, &byOperation, http.StatusOK); err != nil {
+		return ContentRestrictions{}, err
+	}
+
+	subjects := func(operation string) RestrictionSubjects {
+		var result RestrictionSubjects
+
+		restrictions := byOperation[operation].Restrictions
+
+		for _, user := range restrictions.User.Results {
+			result.Users = append(result.Users, user.AccountId)
+		}
+
+		for _, group := range restrictions.Group.Results {
+			result.Groups = append(result.Groups, group.Name)
+		}
+
+		sort.Strings(result.Users)
+		sort.Strings(result.Groups)
+
+		return result
+	}
+
+	return ContentRestrictions{
+		Read:   subjects(RestrictionOperationRead),
+		Update: subjects(RestrictionOperationUpdate),
+	}, nil
+}
+
+// SetContentRestrictions replaces every read and update restriction of a
+// page with restrictions.
+func (c *Client) SetContentRestrictions(ctx context.Context, contentId int64, restrictions ContentRestrictions) error {
+	requestUrl := fmt.Sprintf(contentRestrictionsBaseUrlFormat, c.config.baseUrl, contentId)
+
+	request := []ContentRestrictionOperationRequest{
+		NewContentRestrictionOperationRequest(RestrictionOperationRead, restrictions.Read),
+		NewContentRestrictionOperationRequest(RestrictionOperationUpdate, restrictions.Update),
+	}
+
+	return c.doJSON(ctx, "PUT", requestUrl, request, nil, http.StatusOK)
+}
+
+// NewContentRestrictionOperationRequest builds the v1 request body restricting
+// operation to subjects.
+func NewContentRestrictionOperationRequest(operation string, subjects RestrictionSubjects) ContentRestrictionOperationRequest {
+	request := ContentRestrictionOperationRequest{
+		Operation: operation,
+		Restrictions: ContentRestrictionSubjectsRequest{
+			User:  make([]ContentRestrictionUser, 0, len(subjects.Users)),
+			Group: make([]ContentRestrictionGroup, 0, len(subjects.Groups)),
+		},
+	}
+
+	for _, accountId := range subjects.Users {
+		request.Restrictions.User = append(request.Restrictions.User, ContentRestrictionUser{Type: "known", AccountId: accountId})
+	}
+
+	for _, name := range subjects.Groups {
+		request.Restrictions.Group = append(request.Restrictions.Group, ContentRestrictionGroup{Type: "group", Name: name})
+	}
+
+	return request
+}
@@ -0,0 +1,64 @@
+package confluence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClientGetContentRestrictions(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wiki/rest/api/content/5/restriction/byOperation" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		_, _ = w.Write([]byte(`{
+			"read":{"restrictions":{"user":{"results":[{"accountId":"b"},{"accountId":"a"}]},"group":{"results":[{"name":"oncall"}]}}},
+			"update":{"restrictions":{"user":{"results":[]},"group":{"results":[{"name":"sre"}]}}}
+		}`))
+	})
+
+	restrictions, err := client.GetContentRestrictions(context.Background(), 5)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := fmt.Sprintf("%+v", restrictions); got != "{Read:{Users:[a b] Groups:[oncall]} Update:{Users:[] Groups:[sre]}}" {
+		t.Errorf("restrictions = %s", got)
+	}
+}
+
+func TestClientSetContentRestrictions(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var request []ContentRestrictionOperationRequest
+
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Errorf("unable to decode request: %s", err)
+		}
+
+		if r.Method != http.MethodPut || len(request) != 2 {
+			t.Errorf("unexpected request: %s %+v", r.Method, request)
+			return
+		}
+
+		if read := request[0]; read.Operation != "read" || len(read.Restrictions.Group) != 1 || read.Restrictions.Group[0].Name != "oncall" {
+			t.Errorf("unexpected read restriction: %+v", read)
+		}
+
+		// Clearing a restriction sends empty lists rather than null.
+		if update := request[1]; update.Operation != "update" || update.Restrictions.User == nil || len(update.Restrictions.User) != 0 {
+			t.Errorf("unexpected update restriction: %+v", update)
+		}
+
+		_, _ = w.Write([]byte(`{"results":[]}`))
+	})
+
+	err := client.SetContentRestrictions(context.Background(), 5, ContentRestrictions{Read: RestrictionSubjects{Groups: []string{"oncall"}}})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
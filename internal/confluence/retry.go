@@ -0,0 +1,167 @@
+package confluence
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultMaxRetries int           = 4
+	DefaultMaxWait    time.Duration = 30 * time.Second
+
+	defaultRetryBaseDelay time.Duration = 500 * time.Millisecond
+)
+
+// RetryConfig controls how the client retries rate limited (429) and
+// transient gateway (502, 503, 504) responses. POST requests are not
+// idempotent and only retry when the server asks for it, see isRetryable.
+type RetryConfig struct {
+	// MaxRetries is the number of retries after the first attempt. Zero
+	// disables retries.
+	MaxRetries int
+	// MaxWait caps a single wait between attempts, including waits requested
+	// by a Retry-After header.
+	MaxWait time.Duration
+
+	baseDelay time.Duration
+}
+
+// DefaultRetryConfig returns the retry settings used when none are given.
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxRetries: DefaultMaxRetries,
+		MaxWait:    DefaultMaxWait,
+		baseDelay:  defaultRetryBaseDelay,
+	}
+}
+
+// WithRetry overrides the default retry behaviour of the client.
+func WithRetry(maxRetries int, maxWait time.Duration) ClientOption {
+	return func(c *Client) {
+		c.retry.MaxRetries = maxRetries
+		c.retry.MaxWait = maxWait
+	}
+}
+
+// isRetryable reports whether resp can be retried. A 502 or 504 may arrive
+// after the server applied the request, so those are only retried for
+// idempotent methods. POST is retried when the server asks for it with a 429,
+// or with a 503 carrying Retry-After.
+func isRetryable(method string, resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusServiceUnavailable:
+		return method != http.MethodPost || resp.Header.Get("Retry-After") != ""
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return method != http.MethodPost
+	}
+
+	return false
+}
+
+// do sends req, retrying retryable responses with exponential backoff and
+// jitter. A Retry-After header from the server takes precedence over the
+// computed backoff. The last response is returned once retries run out.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := c.httpClient.Do(req)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !isRetryable(req.Method, resp) || attempt >= c.retry.MaxRetries {
+			return resp, nil
+		}
+
+		if req.Body != nil && req.GetBody == nil {
+			// The body has been consumed and cannot be replayed.
+			return resp, nil
+		}
+
+		wait := c.retry.backoff(attempt, resp.Header.Get("Retry-After"))
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		if err := sleepContext(req.Context(), wait); err != nil {
+			return nil, err
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+
+			if err != nil {
+				return nil, err
+			}
+
+			req.Body = body
+		}
+	}
+}
+
+// backoff returns how long to wait before the next attempt.
+func (r RetryConfig) backoff(attempt int, retryAfter string) time.Duration {
+	wait, ok := parseRetryAfter(retryAfter)
+
+	if !ok {
+		ceiling := r.baseDelay << uint(attempt)
+
+		if ceiling <= 0 || ceiling > r.MaxWait {
+			ceiling = r.MaxWait
+		}
+
+		// Jitter spreads out clients that were throttled together.
+		wait = ceiling/2 + time.Duration(rand.Int63n(int64(ceiling/2)+1))
+	}
+
+	if wait > r.MaxWait {
+		wait = r.MaxWait
+	}
+
+	return wait
+}
+
+// parseRetryAfter understands both the delay-seconds and HTTP-date forms.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+
+		if wait < 0 {
+			wait = 0
+		}
+
+		return wait, true
+	}
+
+	return 0, false
+}
+
+func sleepContext(ctx context.Context, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,197 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// scriptedHandler replies with the given status codes in order, then 200.
+func scriptedHandler(t *testing.T, statuses []int, bodies *[]string) http.HandlerFunc {
+	t.Helper()
+
+	attempt := 0
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if bodies != nil {
+			body, _ := io.ReadAll(r.Body)
+			*bodies = append(*bodies, string(body))
+		}
+
+		if attempt < len(statuses) {
+			status := statuses[attempt]
+			attempt++
+
+			if status == http.StatusTooManyRequests {
+				w.Header().Set("Retry-After", "0")
+			}
+
+			w.WriteHeader(status)
+			return
+		}
+
+		attempt++
+		_, _ = w.Write([]byte(`{"id":5,"version":{"number":1}}`))
+	}
+}
+
+func fastRetry(maxRetries int) ClientOption {
+	return func(c *Client) {
+		c.retry = RetryConfig{MaxRetries: maxRetries, MaxWait: 10 * time.Millisecond, baseDelay: time.Millisecond}
+	}
+}
+
+func TestClientRetriesRetryableStatuses(t *testing.T) {
+	statuses := []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+	client := newTestClient(t, scriptedHandler(t, statuses, nil), fastRetry(4))
+
+	detail, err := client.GetContentDetailById(context.Background(), 5)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if detail.Id != 5 {
+		t.Errorf("Id = %d, want 5", detail.Id)
+	}
+}
+
+func TestClientRetryGivesUp(t *testing.T) {
+	statuses := []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable}
+	client := newTestClient(t, scriptedHandler(t, statuses, nil), fastRetry(1))
+
+	req, err := client.newRequest(context.Background(), "GET", client.config.baseUrl+"/wiki/api/v2/pages/5", nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := client.do(req)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("StatusCode = %d, want %d", resp.StatusCode, http.StatusServiceUnavailable)
+	}
+}
+
+func TestClientRetryReplaysBody(t *testing.T) {
+	var bodies []string
+	client := newTestClient(t, scriptedHandler(t, []int{http.StatusTooManyRequests}, &bodies), fastRetry(2))
+
+	req, err := client.newRequest(context.Background(), "PUT", client.config.baseUrl+"/wiki/api/v2/pages/5", strings.NewReader(`{"title":"x"}`))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := client.do(req)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp.Body.Close()
+
+	if len(bodies) != 2 || bodies[0] != bodies[1] || bodies[1] != `{"title":"x"}` {
+		t.Errorf("bodies = %q, want the same body twice", bodies)
+	}
+}
+
+func TestClientDoesNotRetryClientErrors(t *testing.T) {
+	statuses := []int{http.StatusBadRequest, http.StatusServiceUnavailable}
+	calls := 0
+	handler := scriptedHandler(t, statuses, nil)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		handler(w, r)
+	}, fastRetry(3))
+
+	req, _ := client.newRequest(context.Background(), "GET", client.config.baseUrl+"/wiki/api/v2/pages/5", nil)
+	resp, err := client.do(req)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp.Body.Close()
+
+	if calls != 1 {
+		t.Errorf("server saw %d requests, want 1", calls)
+	}
+}
+
+func TestClientRetriesPostOnlyWhenAsked(t *testing.T) {
+	tests := []struct {
+		status     int
+		retryAfter string
+		wantCalls  int
+	}{
+		{http.StatusTooManyRequests, "0", 2},
+		{http.StatusServiceUnavailable, "0", 2},
+		{http.StatusServiceUnavailable, "", 1},
+		{http.StatusBadGateway, "", 1},
+		{http.StatusGatewayTimeout, "", 1},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%d %q", test.status, test.retryAfter), func(t *testing.T) {
+			calls := 0
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls++
+
+				if calls == 1 {
+					if test.retryAfter != "" {
+						w.Header().Set("Retry-After", test.retryAfter)
+					}
+
+					w.WriteHeader(test.status)
+					return
+				}
+
+				_, _ = w.Write([]byte(`{"id":5}`))
+			}, fastRetry(3))
+
+			req, _ := client.newRequest(context.Background(), "POST", client.config.baseUrl+"/wiki/api/v2/pages", strings.NewReader(`{"title":"x"}`))
+			resp, err := client.do(req)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			resp.Body.Close()
+
+			if calls != test.wantCalls {
+				t.Errorf("server saw %d requests, want %d", calls, test.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryConfigBackoff(t *testing.T) {
+	retry := RetryConfig{MaxRetries: 5, MaxWait: 4 * time.Second, baseDelay: time.Second}
+
+	if wait := retry.backoff(0, "2"); wait != 2*time.Second {
+		t.Errorf("Retry-After seconds: wait = %s, want 2s", wait)
+	}
+
+	if wait := retry.backoff(0, "120"); wait != 4*time.Second {
+		t.Errorf("Retry-After capped: wait = %s, want 4s", wait)
+	}
+
+	for attempt := 0; attempt < 6; attempt++ {
+		wait := retry.backoff(attempt, "")
+
+		if wait <= 0 || wait > retry.MaxWait {
+			t.Errorf("attempt %d: wait = %s, want within (0, %s]", attempt, wait, retry.MaxWait)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+const (
+	// CQL search is only supported by the v1 API.
+	contentSearchBaseUrlFormat string = "%s/wiki/rest/api/content/search?cql=%s&expand=space,version,ancestors&limit=100"
+	contentWebUiBaseUrlFormat  string = "%s/wiki%s"
+)
+
+// ContentSearchResult is a single piece of content matched by a CQL search.
+type ContentSearchResult struct {
+	Id        int64                    `json:"id,string"`
+	Type      string                   `json:"type"`
+	Title     string                   `json:"title"`
+	Space     ContentSearchSpace       `json:"space"`
+	Version   ContentSearchVersion     `json:"version"`
+	Ancestors []ContentSearchAncestor  `json:"ancestors"`
+	Links     ContentSearchResultLinks `json:"_links"`
+	// Url is the address of the content in the Confluence UI.
+	Url string `json:"-"`
+}
+
+type ContentSearchSpace struct {
+	Id  int64  `json:"id"`
+	Key string `json:"key"`
+}
+
+type ContentSearchVersion struct {
+	Number int64 `json:"number"`
+}
+
+type ContentSearchAncestor struct {
+	Id int64 `json:"id,string"`
+}
+
+type ContentSearchResultLinks struct {
+	WebUi string `json:"webui"`
+}
+
+// ParentContentId returns the id of the direct parent of the content, or zero
+// for content at the root of its space.
+func (r ContentSearchResult) ParentContentId() int64 {
+	if len(r.Ancestors) == 0 {
+		return 0
+	}
+
+	return r.Ancestors[len(r.Ancestors)-1].Id
+}
+
+// SearchContent runs a CQL query, e.g. `space = ENG and label = runbook`, and
+// returns every matching piece of content, following the result cursors.
+func (c *Client) SearchContent(ctx context.Context, cql string) ([]ContentSearchResult, error) {
+	requestUrl := fmt.Sprintf(contentSearchBaseUrlFormat, c.config.baseUrl, url.QueryEscape(cql))
+
+	results, err := getAllResults[ContentSearchResult](ctx, c, requestUrl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range results {
+		results[i].Url = fmt.Sprintf(contentWebUiBaseUrlFormat, c.config.baseUrl, results[i].Links.WebUi)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,42 @@
+package confluence
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestClientSearchContentPaginates(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wiki/rest/api/content/search" || r.URL.Query().Get("cql") != "space = ENG and label = runbook" {
+			t.Errorf("unexpected request: %s %s", r.URL.Path, r.URL.RawQuery)
+		}
+
+		if r.URL.Query().Get("cursor") == "" {
+			_, _ = w.Write([]byte(`{"results":[{"id":"11","type":"page","title":"Runbook","space":{"id":7,"key":"ENG"},"version":{"number":2},"ancestors":[{"id":"1"},{"id":"5"}],"_links":{"webui":"/spaces/ENG/pages/11/Runbook"}}],"_links":{"context":"/wiki","next":"/rest/api/content/search?cql=space+%3D+ENG+and+label+%3D+runbook&cursor=abc"}}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"results":[{"id":"12","type":"page","title":"Home","space":{"id":7,"key":"ENG"},"version":{"number":1}}],"_links":{"context":"/wiki"}}`))
+	})
+
+	serverUrl := client.config.baseUrl
+
+	results, err := client.SearchContent(context.Background(), "space = ENG and label = runbook")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+
+	if first := results[0]; first.Id != 11 || first.ParentContentId() != 5 || first.Space.Key != "ENG" || first.Url != serverUrl+"/wiki/spaces/ENG/pages/11/Runbook" {
+		t.Errorf("unexpected result: %+v", first)
+	}
+
+	if results[1].ParentContentId() != 0 {
+		t.Errorf("ParentContentId = %d, want 0", results[1].ParentContentId())
+	}
+}
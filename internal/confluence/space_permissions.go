@@ -0,0 +1,201 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+)
+
+const (
+	// Space permissions are only supported by the v1 API, which names groups
+	// rather than identifying them by id.
+	spacePermissionsBaseUrlFormat      string = "%s/wiki/rest/api/space/%s?expand=permissions"
+	newSpacePermissionBaseUrlFormat    string = "%s/wiki/rest/api/space/%s/permission"
+	deleteSpacePermissionBaseUrlFormat string = "%s/wiki/rest/api/space/%s/permission/%d"
+)
+
+const (
+	SpacePermissionSubjectUser  string = "user"
+	SpacePermissionSubjectGroup string = "group"
+)
+
+// SpacePermissionSubject is a user, identified by account id, or a group,
+// identified by name.
+type SpacePermissionSubject struct {
+	Type       string `json:"type"`
+	Identifier string `json:"identifier"`
+}
+
+// SpacePermissionOperation is something a subject may do in a space, e.g.
+// create a page.
+type SpacePermissionOperation struct {
+	Key    string `json:"key"`
+	Target string `json:"target"`
+}
+
+func (o SpacePermissionOperation) String() string {
+	return o.Key + ":" + o.Target
+}
+
+// SpacePermission grants a single operation to a subject.
+type SpacePermission struct {
+	Id        int64
+	Subject   SpacePermissionSubject
+	Operation SpacePermissionOperation
+}
+
+type SpacePermissionOperationRequest struct {
+	Subject   SpacePermissionSubject   `json:"subject"`
+	Operation SpacePermissionOperation `json:"operation"`
+}
+
+type spaceWithPermissions struct {
+	Permissions []struct {
+		Id       int64 `json:"id"`
+		Subjects struct {
+			User struct {
+				Results []struct {
+					AccountId string `json:"accountId"`
+				} `json:"results"`
+			} `json:"user"`
+			Group struct {
+				Results []struct {
+					Name string `json:"name"`
+				} `json:"results"`
+			} `json:"group"`
+		} `json:"subjects"`
+		Operation struct {
+			Operation  string `json:"operation"`
+			TargetType string `json:"targetType"`
+		} `json:"operation"`
+	} `json:"permissions"`
+}
+
+// GetSpacePermissions lists the permissions granted to subject in the space
+// with the given key.
+func (c *Client) GetSpacePermissions(ctx context.Context, spaceKey string, subject SpacePermissionSubject) ([]SpacePermission, error) {
+	requestUrl := fmt.Sprintf(spacePermissionsBaseUrlFormat, c.config.baseUrl, url.PathEscape(spaceKey))
+
+	var space spaceWithPermissions
+
+	if err := c.doJSON(ctx, "GET", requestUrl, nil, &space, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	var permissions []SpacePermission
+
+	for _, permission := range space.Permissions {
+		var identifiers []string
+
+		switch subject.Type {
+		case SpacePermissionSubjectUser:
+			for _, user := range permission.Subjects.User.Results {
+				identifiers = append(identifiers, user.AccountId)
+			}
+		case SpacePermissionSubjectGroup:
+			for _, group := range permission.Subjects.Group.Results {
+				identifiers = append(identifiers, group.Name)
+			}
+		}
+
+		for _, identifier := range identifiers {
+			if identifier != subject.Identifier {
+				continue
+			}
+
+			permissions = append(permissions, SpacePermission{
+				Id:      permission.Id,
+				Subject: subject,
+				Operation: SpacePermissionOperation{
+					Key:    permission.Operation.Operation,
+					Target: permission.Operation.TargetType,
+				},
+			})
+		}
+	}
+
+	return permissions, nil
+}
+
+// AddSpacePermission grants operation to subject in the space with the
+// given key.
+func (c *Client) AddSpacePermission(ctx context.Context, spaceKey string, subject SpacePermissionSubject, operation SpacePermissionOperation) error {
+	requestUrl := fmt.Sprintf(newSpacePermissionBaseUrlFormat, c.config.baseUrl, url.PathEscape(spaceKey))
+
+	request := SpacePermissionOperationRequest{Subject: subject, Operation: operation}
+
+	return c.doJSON(ctx, "POST", requestUrl, request, nil, http.StatusOK)
+}
+
+// RemoveSpacePermission revokes a single permission in the space with the
+// given key.
+func (c *Client) RemoveSpacePermission(ctx context.Context, spaceKey string, permissionId int64) error {
+	requestUrl := fmt.Sprintf(deleteSpacePermissionBaseUrlFormat, c.config.baseUrl, url.PathEscape(spaceKey), permissionId)
+
+	return c.doJSON(ctx, "DELETE", requestUrl, nil, nil, http.StatusNoContent)
+}
+
+// SetSpacePermissions reconciles the permissions of subject in the space with
+// operations, granting the missing ones and revoking the extra ones.
+// Confluence requires read:space for every other operation, so it is granted
+// first and revoked last.
+func (c *Client) SetSpacePermissions(ctx context.Context, spaceKey string, subject SpacePermissionSubject, operations []SpacePermissionOperation) error {
+	current, err := c.GetSpacePermissions(ctx, spaceKey, subject)
+
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[SpacePermissionOperation]bool, len(operations))
+
+	for _, operation := range operations {
+		wanted[operation] = true
+	}
+
+	var extra []SpacePermission
+
+	for _, permission := range current {
+		if wanted[permission.Operation] {
+			delete(wanted, permission.Operation)
+			continue
+		}
+
+		extra = append(extra, permission)
+	}
+
+	missing := make([]SpacePermissionOperation, 0, len(wanted))
+
+	for operation := range wanted {
+		missing = append(missing, operation)
+	}
+
+	sort.Slice(missing, func(i, j int) bool { return lessSpaceOperation(missing[i], missing[j]) })
+	sort.Slice(extra, func(i, j int) bool { return lessSpaceOperation(extra[j].Operation, extra[i].Operation) })
+
+	for _, operation := range missing {
+		if err := c.AddSpacePermission(ctx, spaceKey, subject, operation); err != nil {
+			return err
+		}
+	}
+
+	for _, permission := range extra {
+		if err := c.RemoveSpacePermission(ctx, spaceKey, permission.Id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lessSpaceOperation orders read:space before every other operation.
+func lessSpaceOperation(a SpacePermissionOperation, b SpacePermissionOperation) bool {
+	read := SpacePermissionOperation{Key: "read", Target: "space"}
+
+	if a == read || b == read {
+		return a == read && b != read
+	}
+
+	return a.String() < b.String()
+}
@@ -0,0 +1,62 @@
+package confluence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClientSetSpacePermissions(t *testing.T) {
+	var calls []string
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Path != "/wiki/rest/api/space/ENG" || r.URL.Query().Get("expand") != "permissions" {
+				t.Errorf("unexpected request: %s %s", r.URL.Path, r.URL.RawQuery)
+			}
+
+			_, _ = w.Write([]byte(`{"key":"ENG","permissions":[
+				{"id":1,"subjects":{"group":{"results":[{"name":"oncall"}]}},"operation":{"operation":"read","targetType":"space"}},
+				{"id":2,"subjects":{"group":{"results":[{"name":"oncall"}]}},"operation":{"operation":"administer","targetType":"space"}},
+				{"id":3,"subjects":{"group":{"results":[{"name":"other"}]}},"operation":{"operation":"create","targetType":"page"}},
+				{"id":4,"subjects":{"user":{"results":[{"accountId":"oncall"}]}},"operation":{"operation":"export","targetType":"space"}}
+			]}`))
+		case http.MethodPost:
+			var request SpacePermissionOperationRequest
+
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				t.Errorf("unable to decode request: %s", err)
+			}
+
+			calls = append(calls, fmt.Sprintf("add %s %s %s", request.Subject.Type, request.Subject.Identifier, request.Operation))
+			_, _ = w.Write([]byte(`{"id":5}`))
+		case http.MethodDelete:
+			calls = append(calls, "remove "+r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	subject := SpacePermissionSubject{Type: SpacePermissionSubjectGroup, Identifier: "oncall"}
+	operations := []SpacePermissionOperation{{Key: "read", Target: "space"}, {Key: "create", Target: "page"}}
+
+	if err := client.SetSpacePermissions(context.Background(), "ENG", subject, operations); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := "[add group oncall create:page remove /wiki/rest/api/space/ENG/permission/2]"
+	if fmt.Sprint(calls) != want {
+		t.Errorf("calls = %v, want %s", calls, want)
+	}
+}
+
+func TestLessSpaceOperation(t *testing.T) {
+	read := SpacePermissionOperation{Key: "read", Target: "space"}
+	admin := SpacePermissionOperation{Key: "administer", Target: "space"}
+
+	if !lessSpaceOperation(read, admin) || lessSpaceOperation(admin, read) || lessSpaceOperation(read, read) {
+		t.Error("read:space must sort before every other operation")
+	}
+}
@@ -0,0 +1,128 @@
+package confluence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	spacesByKeyBaseUrlFormat string = "%s/wiki/api/v2/spaces?keys=%s&description-format=plain"
+	spaceByIdBaseUrlFormat   string = "%s/wiki/api/v2/spaces/%d?description-format=plain"
+	// Creating, updating and deleting spaces is only supported by the v1 API.
+	newSpaceBaseUrlFormat          string = "%s/wiki/rest/api/space"
+	updateDeleteSpaceBaseUrlFormat string = "%s/wiki/rest/api/space/%s"
+)
+
+// SpaceTypeGlobal is the type of a regular team space.
+const SpaceTypeGlobal string = "global"
+
+// SpaceSettings describes a space to create or the new settings of an
+// existing space.
+type SpaceSettings struct {
+	// Key is only used when creating a space; it cannot be changed.
+	Key         string
+	Name        string
+	Description string
+	// Type is only used when creating a space. Defaults to global.
+	Type string
+	// HomepageId leaves the homepage unchanged when zero.
+	HomepageId int64
+}
+
+// GetSpaceByKey looks up a space by its key, e.g. ENG. A *NotFoundError is
+// returned when no space has that key.
+func (c *Client) GetSpaceByKey(ctx context.Context, spaceKey string) (Space, error) {
+	requestUrl := fmt.Sprintf(spacesByKeyBaseUrlFormat, c.config.baseUrl, url.QueryEscape(spaceKey))
+
+	var page resultsPage[Space]
+
+	if err := c.doJSON(ctx, "GET", requestUrl, nil, &page, http.StatusOK); err != nil {
+		return Space{}, err
+	}
+
+	if len(page.Results) == 0 {
+		return Space{}, &NotFoundError{Kind: "space", Query: "key " + spaceKey}
+	}
+
+	return page.Results[0], nil
+}
+
+// GetSpaceById looks up a space by its numeric identifier.
+func (c *Client) GetSpaceById(ctx context.Context, spaceId int64) (Space, error) {
+	requestUrl := fmt.Sprintf(spaceByIdBaseUrlFormat, c.config.baseUrl, spaceId)
+
+	var space Space
+
+	if err := c.doJSON(ctx, "GET", requestUrl, nil, &space, http.StatusOK); err != nil {
+		return Space{}, err
+	}
+
+	return space, nil
+}
+
+// CreateSpace creates a space and returns it as read back from the API.
+// Confluence creates a homepage for every new space. settings.HomepageId is
+// ignored, replace the homepage with UpdateSpace once the space is recorded.
+func (c *Client) CreateSpace(ctx context.Context, settings SpaceSettings) (Space, error) {
+	if settings.Key == "" {
+		return Space{}, errors.New("a key is required to create a space")
+	}
+
+	request := NewSpaceOperationRequest(SpaceSettings{Name: settings.Name, Description: settings.Description})
+	request.Key = settings.Key
+	request.Type = settings.Type
+
+	if request.Type == "" {
+		request.Type = SpaceTypeGlobal
+	}
+
+	requestUrl := fmt.Sprintf(newSpaceBaseUrlFormat, c.config.baseUrl)
+
+	if err := c.doJSON(ctx, "POST", requestUrl, request, nil, http.StatusOK); err != nil {
+		return Space{}, err
+	}
+
+	return c.GetSpaceByKey(ctx, settings.Key)
+}
+
+// UpdateSpace changes the name, description and, when set, the homepage of
+// the space with the given key.
+func (c *Client) UpdateSpace(ctx context.Context, spaceKey string, settings SpaceSettings) (Space, error) {
+	requestUrl := fmt.Sprintf(updateDeleteSpaceBaseUrlFormat, c.config.baseUrl, url.PathEscape(spaceKey))
+
+	if err := c.doJSON(ctx, "PUT", requestUrl, NewSpaceOperationRequest(settings), nil, http.StatusOK); err != nil {
+		return Space{}, err
+	}
+
+	return c.GetSpaceByKey(ctx, spaceKey)
+}
+
+// DeleteSpace deletes the space with the given key. Confluence removes the
+// space and its content in the background after accepting the request.
+func (c *Client) DeleteSpace(ctx context.Context, spaceKey string) error {
+	requestUrl := fmt.Sprintf(updateDeleteSpaceBaseUrlFormat, c.config.baseUrl, url.PathEscape(spaceKey))
+
+	return c.doJSON(ctx, "DELETE", requestUrl, nil, nil, http.StatusAccepted, http.StatusNoContent)
+}
+
+// NewSpaceOperationRequest builds the v1 request body for a space.
+func NewSpaceOperationRequest(settings SpaceSettings) SpaceOperationRequest {
+	request := SpaceOperationRequest{
+		Name: settings.Name,
+		Description: SpaceDescription{
+			Plain: ContentOperationBodyStorage{
+				Value:          settings.Description,
+				Representation: "plain",
+			},
+		},
+	}
+
+	if settings.HomepageId != 0 {
+		request.Homepage = &SpaceHomepage{Id: settings.HomepageId}
+	}
+
+	return request
+}
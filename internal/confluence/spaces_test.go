@@ -0,0 +1,91 @@
+package confluence
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestClientCreateSpaceThenSetHomepage(t *testing.T) {
+	var requests []SpaceOperationRequest
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost, http.MethodPut:
+			var request SpaceOperationRequest
+
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				t.Errorf("unable to decode request: %s", err)
+			}
+
+			requests = append(requests, request)
+			_, _ = w.Write([]byte(`{"id":7,"key":"ENG"}`))
+		default:
+			_, _ = w.Write([]byte(`{"results":[{"id":7,"key":"ENG","name":"Engineering","homepageId":99,"description":{"plain":{"value":"Docs"}}}]}`))
+		}
+	})
+
+	settings := SpaceSettings{Key: "ENG", Name: "Engineering", Description: "Docs", HomepageId: 99}
+
+	space, err := client.CreateSpace(context.Background(), settings)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if space.Id != 7 || space.Description.Plain.Value != "Docs" {
+		t.Errorf("unexpected space: %+v", space)
+	}
+
+	if len(requests) != 1 {
+		t.Fatalf("got %d requests, want only the create", len(requests))
+	}
+
+	if create := requests[0]; create.Key != "ENG" || create.Type != SpaceTypeGlobal || create.Homepage != nil {
+		t.Errorf("unexpected create request: %+v", create)
+	}
+
+	if _, err := client.UpdateSpace(context.Background(), "ENG", settings); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if update := requests[1]; update.Homepage == nil || update.Homepage.Id != 99 || update.Description.Plain.Value != "Docs" {
+		t.Errorf("unexpected update request: %+v", update)
+	}
+}
+
+func TestClientDeleteSpace(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/wiki/rest/api/space/ENG" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"id":"task-1"}`))
+	})
+
+	if err := client.DeleteSpace(context.Background(), "ENG"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestClientGetSpaceById(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wiki/api/v2/spaces/7" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		_, _ = w.Write([]byte(`{"id":7,"key":"ENG","name":"Engineering","type":"global","status":"current","homepageId":99}`))
+	})
+
+	space, err := client.GetSpaceById(context.Background(), 7)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if space.Key != "ENG" || space.HomepageId != 99 || space.Status != "current" {
+		t.Errorf("unexpected space: %+v", space)
+	}
+}
@@ -0,0 +1,136 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+)
+
+const (
+	contentVersionsBaseUrlFormat string = "%s/wiki/api/v2/pages/%d/versions?limit=250"
+	// Deleting a version is only supported by the v1 API.
+	contentVersionBaseUrlFormat string = "%s/wiki/rest/api/content/%d/version/%d"
+)
+
+// VersionRetention selects which previous versions of a page are kept when
+// pruning history. The current version is always kept.
+type VersionRetention struct {
+	// KeepAll disables pruning.
+	KeepAll bool
+	// KeepLast keeps the newest KeepLast versions, including the current one.
+	KeepLast int64
+	// KeepNewerThan keeps versions created within this duration.
+	KeepNewerThan time.Duration
+}
+
+// VersionPruneResult reports the outcome of RemovePreviousVersions.
+type VersionPruneResult struct {
+	// Deleted holds the version numbers that were removed, as numbered
+	// before pruning started.
+	Deleted []int64
+	// Failed holds one error per version that could not be removed.
+	Failed []*VersionDeleteError
+}
+
+// GetContentVersions lists every version of a page, newest first.
+func (c *Client) GetContentVersions(ctx context.Context, contentId int64) ([]ContentDetailVersion, error) {
+	requestUrl := fmt.Sprintf(contentVersionsBaseUrlFormat, c.config.baseUrl, contentId)
+
+	return getAllResults[ContentDetailVersion](ctx, c, requestUrl)
+}
+
+// DeleteContentVersion removes a single previous version of a page. The
+// current version cannot be deleted.
+func (c *Client) DeleteContentVersion(ctx context.Context, contentId int64, version int64) error {
+	if err := c.deleteContentVersion(ctx, contentId, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) deleteContentVersion(ctx context.Context, contentId int64, version int64) *VersionDeleteError {
+	requestUrl := fmt.Sprintf(contentVersionBaseUrlFormat, c.config.baseUrl, contentId, version)
+
+	err := c.doJSON(ctx, "DELETE", requestUrl, nil, nil, http.StatusNoContent)
+
+	if err != nil {
+		return &VersionDeleteError{ContentId: contentId, Version: version, Err: err}
+	}
+
+	return nil
+}
+
+// RemovePreviousVersions deletes the previous versions of a page that fall
+// outside retention. Every selected version is attempted; the returned error
+// is a *VersionPruneError when any of them could not be removed.
+func (c *Client) RemovePreviousVersions(ctx context.Context, contentId int64, retention VersionRetention) (VersionPruneResult, error) {
+	if retention.KeepAll {
+		return VersionPruneResult{}, nil
+	}
+
+	if retention.KeepNewerThan <= 0 && retention.KeepLast < 1 {
+		return VersionPruneResult{}, ErrInvalidVersionsToKeep
+	}
+
+	versions, err := c.GetContentVersions(ctx, contentId)
+
+	if err != nil {
+		return VersionPruneResult{}, err
+	}
+
+	var result VersionPruneResult
+
+	// Confluence renumbers the versions after a deleted one, so delete from
+	// the newest down to keep the remaining numbers stable.
+	for _, version := range retention.versionsToDelete(versions, time.Now()) {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		if deleteErr := c.deleteContentVersion(ctx, contentId, version); deleteErr != nil {
+			result.Failed = append(result.Failed, deleteErr)
+			continue
+		}
+
+		result.Deleted = append(result.Deleted, version)
+	}
+
+	if len(result.Failed) > 0 {
+		return result, &VersionPruneError{ContentId: contentId, Failed: result.Failed}
+	}
+
+	return result, nil
+}
+
+// versionsToDelete returns the numbers of the versions that fall outside
+// retention, newest first. The current (highest) version is never returned.
+func (r VersionRetention) versionsToDelete(versions []ContentDetailVersion, now time.Time) []int64 {
+	if r.KeepAll || len(versions) == 0 {
+		return nil
+	}
+
+	sorted := make([]ContentDetailVersion, len(versions))
+	copy(sorted, versions)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Number > sorted[j].Number })
+
+	var numbers []int64
+
+	for i, version := range sorted {
+		if i == 0 {
+			continue
+		}
+
+		if r.KeepNewerThan > 0 {
+			if version.CreatedAt.Before(now.Add(-r.KeepNewerThan)) {
+				numbers = append(numbers, version.Number)
+			}
+		} else if int64(i) >= r.KeepLast {
+			numbers = append(numbers, version.Number)
+		}
+	}
+
+	return numbers
+}
@@ -0,0 +1,102 @@
+package confluence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClientGetContentVersionsPaginates(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wiki/api/v2/pages/8/versions" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		if r.URL.Query().Get("cursor") == "" {
+			_, _ = w.Write([]byte(`{"results":[{"number":3},{"number":2}],"_links":{"next":"/wiki/api/v2/pages/8/versions?limit=250&cursor=abc"}}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"results":[{"number":1,"authorId":"xyz","minorEdit":true}],"_links":{}}`))
+	})
+
+	versions, err := client.GetContentVersions(context.Background(), 8)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(versions) != 3 || versions[2].Number != 1 || versions[2].AuthorId != "xyz" || !versions[2].MinorEdit {
+		t.Errorf("unexpected versions: %+v", versions)
+	}
+}
+
+func TestVersionRetentionVersionsToDelete(t *testing.T) {
+	now := time.Date(2023, 6, 30, 0, 0, 0, 0, time.UTC)
+	versions := []ContentDetailVersion{
+		{Number: 4, CreatedAt: now.Add(-1 * time.Hour)},
+		{Number: 3, CreatedAt: now.Add(-48 * time.Hour)},
+		{Number: 2, CreatedAt: now.Add(-72 * time.Hour)},
+		{Number: 1, CreatedAt: now.Add(-96 * time.Hour)},
+	}
+
+	tests := []struct {
+		retention VersionRetention
+		want      []int64
+	}{
+		{VersionRetention{KeepAll: true}, nil},
+		{VersionRetention{KeepLast: 1}, []int64{3, 2, 1}},
+		{VersionRetention{KeepLast: 3}, []int64{1}},
+		{VersionRetention{KeepLast: 10}, nil},
+		{VersionRetention{KeepNewerThan: 60 * time.Hour}, []int64{2, 1}},
+		{VersionRetention{KeepNewerThan: time.Minute}, []int64{3, 2, 1}},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%+v", test.retention), func(t *testing.T) {
+			if got := test.retention.versionsToDelete(versions, now); fmt.Sprint(got) != fmt.Sprint(test.want) {
+				t.Errorf("versionsToDelete = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestClientRemovePreviousVersions(t *testing.T) {
+	var deleted []string
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"results":[{"number":4},{"number":3},{"number":2},{"number":1}],"_links":{}}`))
+		case r.URL.Path == "/wiki/rest/api/content/8/version/2":
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}, fastRetry(0))
+
+	if _, err := client.RemovePreviousVersions(context.Background(), 8, VersionRetention{}); !errors.Is(err, ErrInvalidVersionsToKeep) {
+		t.Errorf("error = %v, want ErrInvalidVersionsToKeep", err)
+	}
+
+	result, err := client.RemovePreviousVersions(context.Background(), 8, VersionRetention{KeepLast: 1})
+
+	var pruneErr *VersionPruneError
+	if !errors.As(err, &pruneErr) || len(pruneErr.Failed) != 1 || pruneErr.Failed[0].Version != 2 || !hasStatusCode(pruneErr.Failed[0], http.StatusForbidden) {
+		t.Fatalf("error = %v, want *VersionPruneError for version 2", err)
+	}
+
+	if fmt.Sprint(result.Deleted) != "[3 1]" {
+		t.Errorf("Deleted = %v, want [3 1]", result.Deleted)
+	}
+
+	want := "[/wiki/rest/api/content/8/version/3 /wiki/rest/api/content/8/version/2 /wiki/rest/api/content/8/version/1]"
+	if fmt.Sprint(deleted) != want {
+		t.Errorf("deleted %v, want %s", deleted, want)
+	}
+}
@@ -0,0 +1,494 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/william-powell/terraform-provider-confluence/internal/confluence"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &attachmentResource{}
+	_ resource.ResourceWithConfigure      = &attachmentResource{}
+	_ resource.ResourceWithValidateConfig = &attachmentResource{}
+	_ resource.ResourceWithModifyPlan     = &attachmentResource{}
+)
+
+// NewAttachmentResource is a helper function to simplify the provider implementation.
+func NewAttachmentResource() resource.Resource {
+	return &attachmentResource{}
+}
+
+// attachmentResource is the resource implementation.
+type attachmentResource struct {
+	client *confluence.Client
+}
+
+// attachmentResourceModel maps the resource schema data.
+type attachmentResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	PageId        types.Int64  `tfsdk:"page_id"`
+	Source        types.String `tfsdk:"source"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	Filename      types.String `tfsdk:"filename"`
+	MediaType     types.String `tfsdk:"media_type"`
+	Comment       types.String `tfsdk:"comment"`
+	ContentHash   types.String `tfsdk:"content_hash"`
+	VersionNumber types.Int64  `tfsdk:"version_number"`
+	FileSize      types.Int64  `tfsdk:"file_size"`
+	DownloadUrl   types.String `tfsdk:"download_url"`
+}
+
+// setAttachment maps an attachment returned by the API onto the model,
+// leaving configuration-only attributes untouched. The filename and media type
+// are only refreshed by Read, as uploads keep the planned values.
+func (m *attachmentResourceModel) setAttachment(attachment confluence.Attachment) {
+	m.Id = types.StringValue(attachment.Id)
+	m.VersionNumber = types.Int64Value(attachment.Version.Number)
+	m.FileSize = types.Int64Value(attachment.FileSize)
+	m.DownloadUrl = types.StringValue(attachment.DownloadUrl)
+}
+
+// content reads the file to upload from source or content_base64.
+func (m *attachmentResourceModel) content() ([]byte, error) {
+	if !m.Source.IsNull() {
+		return os.ReadFile(m.Source.ValueString())
+	}
+
+	return base64.StdEncoding.DecodeString(m.ContentBase64.ValueString())
+}
+
+// upload builds the client representation of the planned file.
+func (m *attachmentResourceModel) upload(content []byte) confluence.AttachmentUpload {
+	return confluence.AttachmentUpload{
+		Filename:  m.Filename.ValueString(),
+		MediaType: m.MediaType.ValueString(),
+		Content:   content,
+		Comment:   m.Comment.ValueString(),
+	}
+}
+
+// setDefaults fills in an unknown filename with the file name of source, and
+// an unknown media type with the type implied by the filename extension, or
+// else by content.
+func (m *attachmentResourceModel) setDefaults(content []byte) {
+	if m.Filename.IsUnknown() && !m.Source.IsNull() {
+		m.Filename = types.StringValue(filepath.Base(m.Source.ValueString()))
+	}
+
+	if m.MediaType.IsUnknown() && !m.Filename.IsUnknown() {
+		mediaType := mime.TypeByExtension(filepath.Ext(m.Filename.ValueString()))
+
+		if mediaType == "" {
+			mediaType = http.DetectContentType(content)
+		}
+
+		m.MediaType = types.StringValue(mediaType)
+	}
+}
+
+// contentHash returns the hex encoded SHA-256 of content.
+func contentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *attachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*confluence.Client)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *attachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_attachment"
+}
+
+// Schema defines the schema for the resource.
+func (r *attachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a file attached to a Confluence Page. Changes to the file are detected by its hash and uploaded as a new version of the attachment. A new version uploaded outside of Terraform is replaced on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier for this attachment, e.g. att123.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"page_id": schema.Int64Attribute{
+				Description: "The id of the page to attach the file to. Changing the page creates a new attachment.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Description: "The path of a local file to upload. Exactly one of source or content_base64 must be set.",
+				Optional:    true,
+			},
+			"content_base64": schema.StringAttribute{
+				Description: "The base64 encoded content to upload, e.g. from filebase64(). Exactly one of source or content_base64 must be set.",
+				Optional:    true,
+			},
+			"filename": schema.StringAttribute{
+				Description: "The name of the attachment, referenced by `<ri:attachment ri:filename=\"...\"/>` in a page body. Defaults to the file name of source and is required with content_base64. Changing the filename creates a new attachment.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"media_type": schema.StringAttribute{
+				Description: "The media type of the attachment, e.g. image/png. Defaults to the type implied by the filename extension, or else by the content.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"comment": schema.StringAttribute{
+				Description: "The comment recorded with each version of the attachment Terraform uploads.",
+				Optional:    true,
+			},
+			"content_hash": schema.StringAttribute{
+				Description: "The SHA-256 of the uploaded content, used to detect changes to the file.",
+				Computed:    true,
+			},
+			"version_number": schema.Int64Attribute{
+				Description: "The current version number of the attachment.",
+				Computed:    true,
+			},
+			"file_size": schema.Int64Attribute{
+				Description: "The size of the attachment in bytes.",
+				Computed:    true,
+			},
+			"download_url": schema.StringAttribute{
+				Description: "The address to download the current version of the attachment from.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures the content comes from exactly one place and that an
+// attachment from content_base64 is named.
+func (r *attachmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config attachmentResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Source.IsUnknown() || config.ContentBase64.IsUnknown() {
+		return
+	}
+
+	if config.Source.IsNull() == config.ContentBase64.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Invalid Attachment Content",
+			"Exactly one of source or content_base64 must be set.",
+		)
+		return
+	}
+
+	if !config.ContentBase64.IsNull() && config.Filename.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("filename"),
+			"Missing Attachment Filename",
+			"filename must be set when uploading content_base64.",
+		)
+	}
+}
+
+// ModifyPlan hashes the file to upload so changes to its content show up in
+// the plan, and fills in the default filename and media type. Content only
+// known after apply leaves those unknown for Create and Update to resolve.
+func (r *attachmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan attachmentResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Filename.IsNull() {
+		plan.Filename = types.StringUnknown()
+	}
+
+	if config.MediaType.IsNull() {
+		plan.MediaType = types.StringUnknown()
+	}
+
+	// The content is only known after apply, Create and Update hash it and
+	// fill in the defaults then.
+	contentKnown := !config.Source.IsUnknown() && !config.ContentBase64.IsUnknown()
+	plan.ContentHash = types.StringUnknown()
+
+	if contentKnown {
+		content, err := plan.content()
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Attachment Content",
+				err.Error(),
+			)
+			return
+		}
+
+		plan.ContentHash = types.StringValue(contentHash(content))
+		plan.setDefaults(content)
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state attachmentResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// An unknown filename may differ from the current one.
+		if !plan.Filename.Equal(state.Filename) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("filename"))
+		}
+
+		// A new version will be uploaded.
+		if !contentKnown || !plan.ContentHash.Equal(state.ContentHash) || !plan.MediaType.Equal(state.MediaType) || !plan.Comment.Equal(state.Comment) {
+			plan.VersionNumber = types.Int64Unknown()
+			plan.FileSize = types.Int64Unknown()
+			plan.DownloadUrl = types.StringUnknown()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// plannedContent reads the file to upload and checks it still matches the
+// hash in the plan. When the content was unknown at plan time, the hash and
+// the default filename and media type are filled in instead.
+func plannedContent(plan *attachmentResourceModel) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	content, err := plan.content()
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Attachment Content",
+			err.Error(),
+		)
+		return nil, diags
+	}
+
+	hash := contentHash(content)
+
+	if plan.ContentHash.IsUnknown() {
+		plan.ContentHash = types.StringValue(hash)
+		plan.setDefaults(content)
+
+		return content, diags
+	}
+
+	if hash != plan.ContentHash.ValueString() {
+		diags.AddError(
+			"Attachment Content Changed",
+			fmt.Sprintf("The content to upload changed after the plan was made, its SHA-256 is now %s. Run the plan again.", hash),
+		)
+		return nil, diags
+	}
+
+	return content, diags
+}
+
+// Create a new resource.
+func (r *attachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create attachment resource")
+	// Retrieve values from plan
+	var plan attachmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, diags := plannedContent(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attachment, err := r.client.CreateAttachment(ctx, plan.PageId.ValueInt64(), plan.upload(content))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Attachment",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	plan.setAttachment(attachment)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Created attachment resource", map[string]any{"success": true})
+}
+
+// Read resource information.
+func (r *attachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read attachment resource")
+	// Get current state
+	var state attachmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attachment, err := r.client.GetAttachmentById(ctx, state.Id.ValueString())
+
+	// Treat HTTP 404 Not Found status as a signal to remove/recreate resource
+	if confluence.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Attachment",
+			err.Error(),
+		)
+		return
+	}
+
+	// The content of a version uploaded outside of Terraform is unknown, so
+	// clear the hash to upload the configured file again.
+	if attachment.Version.Number != state.VersionNumber.ValueInt64() {
+		state.ContentHash = types.StringValue("")
+	}
+
+	// Map response body to model
+	state.setAttachment(attachment)
+	state.Filename = types.StringValue(attachment.Title)
+	state.MediaType = types.StringValue(attachment.MediaType)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Finished reading attachment resource", map[string]any{"success": true})
+}
+
+func (r *attachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update attachment resource")
+	// Retrieve values from plan
+	var plan attachmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state attachmentResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Switching between source and content_base64 for the same file does
+	// not need a new version.
+	if plan.ContentHash.Equal(state.ContentHash) && plan.MediaType.Equal(state.MediaType) && plan.Comment.Equal(state.Comment) {
+		plan.VersionNumber = state.VersionNumber
+		plan.FileSize = state.FileSize
+		plan.DownloadUrl = state.DownloadUrl
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	content, diags := plannedContent(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attachment, err := r.client.UpdateAttachmentData(ctx, plan.PageId.ValueInt64(), state.Id.ValueString(), plan.upload(content))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Attachment",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.setAttachment(attachment)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Updated attachment resource", map[string]any{"success": true})
+}
+
+func (r *attachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete attachment resource")
+	// Retrieve values from state
+	var state attachmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAttachment(ctx, state.Id.ValueString())
+
+	// The attachment is already gone, nothing left to delete.
+	if confluence.IsNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Attachment",
+			err.Error(),
+		)
+		return
+	}
+	tflog.Debug(ctx, "Deleted attachment resource", map[string]any{"success": true})
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAttachmentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "confluence_page" "test" {
+  title = "Unit Test Attachment Page"
+  parent_id = "33296"
+  body = "<p>Unit Test Attachment Page</p>"
+}
+
+resource "confluence_attachment" "test" {
+  page_id = confluence_page.test.id
+  content_base64 = base64encode("hello")
+  filename = "hello.txt"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("confluence_attachment.test", "media_type", "text/plain; charset=utf-8"),
+					resource.TestCheckResourceAttr("confluence_attachment.test", "content_hash", "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"),
+					resource.TestCheckResourceAttr("confluence_attachment.test", "version_number", "1"),
+					resource.TestCheckResourceAttr("confluence_attachment.test", "file_size", "5"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "confluence_page" "test" {
+  title = "Unit Test Attachment Page"
+  parent_id = "33296"
+  body = "<p>Unit Test Attachment Page</p>"
+}
+
+resource "confluence_attachment" "test" {
+  page_id = confluence_page.test.id
+  content_base64 = base64encode("hello, world")
+  filename = "hello.txt"
+  comment = "Second version"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("confluence_attachment.test", "version_number", "2"),
+					resource.TestCheckResourceAttr("confluence_attachment.test", "file_size", "12"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAttachmentResource_unknownContent(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The content depends on the id of the page, which is only
+				// known after apply.
+				Config: providerConfig + `
+resource "confluence_page" "test" {
+  title = "Unit Test Unknown Attachment Page"
+  parent_id = "33296"
+  body = "<p>Unit Test Unknown Attachment Page</p>"
+}
+
+resource "confluence_attachment" "test" {
+  page_id = confluence_page.test.id
+  content_base64 = base64encode("page ${confluence_page.test.id}")
+  filename = "page.txt"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("confluence_attachment.test", "filename", "page.txt"),
+					resource.TestCheckResourceAttr("confluence_attachment.test", "media_type", "text/plain; charset=utf-8"),
+					resource.TestCheckResourceAttrSet("confluence_attachment.test", "content_hash"),
+					resource.TestCheckResourceAttr("confluence_attachment.test", "version_number", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestPlannedContentUnknownAtPlan(t *testing.T) {
+	plan := attachmentResourceModel{
+		Source:        types.StringNull(),
+		ContentBase64: types.StringValue("aGVsbG8="),
+		Filename:      types.StringValue("hello.txt"),
+		MediaType:     types.StringUnknown(),
+		ContentHash:   types.StringUnknown(),
+	}
+
+	content, diags := plannedContent(&plan)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if string(content) != "hello" {
+		t.Errorf("content = %q, want hello", content)
+	}
+
+	if plan.ContentHash.ValueString() != "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824" || plan.MediaType.ValueString() != "text/plain; charset=utf-8" {
+		t.Errorf("unexpected plan: %+v", plan)
+	}
+
+	plan.ContentHash = types.StringValue("stale")
+
+	if _, diags := plannedContent(&plan); !diags.HasError() {
+		t.Error("expected a changed content error")
+	}
+}
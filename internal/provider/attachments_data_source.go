@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/william-powell/terraform-provider-confluence/internal/confluence"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &attachmentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &attachmentsDataSource{}
+)
+
+// NewAttachmentsDataSource is a helper function to simplify the provider implementation.
+func NewAttachmentsDataSource() datasource.DataSource {
+	return &attachmentsDataSource{}
+}
+
+// attachmentsDataSource is the data source implementation.
+type attachmentsDataSource struct {
+	client *confluence.Client
+}
+
+// attachmentsDataSourceModel maps the data source schema data.
+type attachmentsDataSourceModel struct {
+	PageId      types.Int64                  `tfsdk:"page_id"`
+	Filename    types.String                 `tfsdk:"filename"`
+	Attachments []attachmentsAttachmentModel `tfsdk:"attachments"`
+}
+
+// attachmentsAttachmentModel maps a single attachment of the page.
+type attachmentsAttachmentModel struct {
+	Id            types.String `tfsdk:"id"`
+	Title         types.String `tfsdk:"title"`
+	MediaType     types.String `tfsdk:"media_type"`
+	FileSize      types.Int64  `tfsdk:"file_size"`
+	VersionNumber types.Int64  `tfsdk:"version_number"`
+	DownloadUrl   types.String `tfsdk:"download_url"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *attachmentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*confluence.Client)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *attachmentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_attachments"
+}
+
+// Schema defines the schema for the data source.
+func (d *attachmentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch the files attached to a page.",
+		Attributes: map[string]schema.Attribute{
+			"page_id": schema.Int64Attribute{
+				Description: "Identifier for the Confluence page.",
+				Required:    true,
+			},
+			"filename": schema.StringAttribute{
+				Description: "Only return the attachment with this name.",
+				Optional:    true,
+			},
+			"attachments": schema.ListNestedAttribute{
+				Description: "Every attachment of the page.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Identifier for the attachment, e.g. att123.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The filename of the attachment.",
+							Computed:    true,
+						},
+						"media_type": schema.StringAttribute{
+							Description: "The media type of the attachment, e.g. image/png.",
+							Computed:    true,
+						},
+						"file_size": schema.Int64Attribute{
+							Description: "The size of the attachment in bytes.",
+							Computed:    true,
+						},
+						"version_number": schema.Int64Attribute{
+							Description: "The current version number of the attachment.",
+							Computed:    true,
+						},
+						"download_url": schema.StringAttribute{
+							Description: "The address to download the current version of the attachment from.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *attachmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read attachments data source")
+	var state attachmentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attachments, err := d.client.GetPageAttachments(ctx, state.PageId.ValueInt64(), state.Filename.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Attachments",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Attachments = make([]attachmentsAttachmentModel, 0, len(attachments))
+
+	for _, attachment := range attachments {
+		state.Attachments = append(state.Attachments, attachmentsAttachmentModel{
+			Id:            types.StringValue(attachment.Id),
+			Title:         types.StringValue(attachment.Title),
+			MediaType:     types.StringValue(attachment.MediaType),
+			FileSize:      types.Int64Value(attachment.FileSize),
+			VersionNumber: types.Int64Value(attachment.Version.Number),
+			DownloadUrl:   types.StringValue(attachment.DownloadUrl),
+		})
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading attachments data source", map[string]any{"success": true})
+}
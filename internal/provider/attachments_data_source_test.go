@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAttachmentsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "confluence_page" "test" {
+  title = "Unit Test Attachments Page"
+  parent_id = "33296"
+  body = "<p>Unit Test Attachments Page</p>"
+}
+
+resource "confluence_attachment" "first" {
+  page_id = confluence_page.test.id
+  content_base64 = base64encode("first")
+  filename = "first.txt"
+}
+
+resource "confluence_attachment" "second" {
+  page_id = confluence_page.test.id
+  content_base64 = base64encode("second")
+  filename = "second.txt"
+}
+
+data "confluence_attachments" "all" {
+	page_id = confluence_page.test.id
+
+	depends_on = [confluence_attachment.first, confluence_attachment.second]
+}
+
+data "confluence_attachments" "second" {
+	page_id = confluence_page.test.id
+	filename = confluence_attachment.second.filename
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.confluence_attachments.all", "attachments.#", "2"),
+					resource.TestCheckResourceAttr("data.confluence_attachments.second", "attachments.#", "1"),
+					resource.TestCheckResourceAttrPair("data.confluence_attachments.second", "attachments.0.id", "confluence_attachment.second", "id"),
+					resource.TestCheckResourceAttrPair("data.confluence_attachments.second", "attachments.0.download_url", "confluence_attachment.second", "download_url"),
+				),
+			},
+		},
+	})
+}
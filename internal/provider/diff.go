@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxDiffCells bounds the size of the table used to compute a line diff so a
+// pair of huge pages cannot exhaust memory. Larger inputs are reported as a
+// single replaced block.
+const maxDiffCells = 4000000
+
+// storageLines splits Confluence storage format into lines, breaking between
+// adjacent tags so bodies stored on a single line still diff usefully.
+func storageLines(body string) []string {
+	body = strings.ReplaceAll(body, "><", ">\n<")
+
+	return strings.Split(body, "\n")
+}
+
+// diffContextLines is the number of unchanged lines shown around each change.
+const diffContextLines = 3
+
+// diffLine is a line of a diff with its marker.
+type diffLine struct {
+	marker string
+	text   string
+}
+
+// lineDiff renders a minimal unified-style diff of the lines of from and to.
+// Unchanged lines are prefixed with two spaces, removed lines with "- " and
+// added lines with "+ ". Only diffContextLines unchanged lines are kept around
+// each change, the rest are counted instead.
+func lineDiff(from string, to string) string {
+	a := storageLines(from)
+	b := storageLines(to)
+
+	// Trim the common prefix and suffix before computing the table.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var lines []diffLine
+
+	addLines := func(marker string, text []string) {
+		for _, line := range text {
+			lines = append(lines, diffLine{marker: marker, text: line})
+		}
+	}
+
+	addLines("  ", a[:prefix])
+
+	midA := a[prefix : len(a)-suffix]
+	midB := b[prefix : len(b)-suffix]
+
+	if len(midA)*len(midB) > maxDiffCells {
+		addLines("- ", midA)
+		addLines("+ ", midB)
+	} else {
+		// lcs[i][j] is the length of the longest common subsequence of
+		// midA[i:] and midB[j:].
+		lcs := make([][]int, len(midA)+1)
+		for i := range lcs {
+			lcs[i] = make([]int, len(midB)+1)
+		}
+
+		for i := len(midA) - 1; i >= 0; i-- {
+			for j := len(midB) - 1; j >= 0; j-- {
+				if midA[i] == midB[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else if lcs[i+1][j] >= lcs[i][j+1] {
+					lcs[i][j] = lcs[i+1][j]
+				} else {
+					lcs[i][j] = lcs[i][j+1]
+				}
+			}
+		}
+
+		i, j := 0, 0
+		for i < len(midA) || j < len(midB) {
+			switch {
+			case i < len(midA) && j < len(midB) && midA[i] == midB[j]:
+				addLines("  ", midA[i:i+1])
+				i++
+				j++
+			case j < len(midB) && (i == len(midA) || lcs[i][j+1] > lcs[i+1][j]):
+				addLines("+ ", midB[j:j+1])
+				j++
+			default:
+				addLines("- ", midA[i:i+1])
+				i++
+			}
+		}
+	}
+
+	addLines("  ", a[len(a)-suffix:])
+
+	return renderDiff(lines)
+}
+
+// renderDiff writes lines, collapsing each run of unchanged lines to the
+// diffContextLines next to a change.
+func renderDiff(lines []diffLine) string {
+	var out strings.Builder
+
+	write := func(run []diffLine) {
+		for _, line := range run {
+			out.WriteString(line.marker)
+			out.WriteString(line.text)
+			out.WriteString("\n")
+		}
+	}
+
+	for i := 0; i < len(lines); {
+		if lines[i].marker != "  " {
+			write(lines[i : i+1])
+			i++
+			continue
+		}
+
+		end := i
+		for end < len(lines) && lines[end].marker == "  " {
+			end++
+		}
+
+		run := lines[i:end]
+
+		// Keep context after a preceding change and before a following one.
+		before, after := 0, 0
+		if i > 0 {
+			before = diffContextLines
+		}
+		if end < len(lines) {
+			after = diffContextLines
+		}
+
+		if len(run) <= before+after {
+			write(run)
+		} else {
+			hidden := len(run) - before - after
+
+			write(run[:before])
+
+			if hidden == 1 {
+				out.WriteString("  … 1 unchanged line\n")
+			} else {
+				fmt.Fprintf(&out, "  … %d unchanged lines\n", hidden)
+			}
+
+			write(run[len(run)-after:])
+		}
+
+		i = end
+	}
+
+	return out.String()
+}
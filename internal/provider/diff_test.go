@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestLineDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want string
+	}{
+		{
+			name: "unchanged",
+			from: "<p>a</p><p>b</p>",
+			to:   "<p>a</p><p>b</p>",
+			want: "  … 2 unchanged lines\n",
+		},
+		{
+			name: "changed line",
+			from: "<p>a</p><p>b</p><p>c</p>",
+			to:   "<p>a</p><p>x</p><p>c</p>",
+			want: "  <p>a</p>\n- <p>b</p>\n+ <p>x</p>\n  <p>c</p>\n",
+		},
+		{
+			name: "added and removed lines",
+			from: "<p>a</p><p>b</p>",
+			to:   "<p>b</p><p>c</p>",
+			want: "- <p>a</p>\n  <p>b</p>\n+ <p>c</p>\n",
+		},
+		{
+			name: "multiline body",
+			from: "one\ntwo",
+			to:   "one\ntwo\nthree",
+			want: "  one\n  two\n+ three\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := lineDiff(test.from, test.to); got != test.want {
+				t.Errorf("lineDiff() =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLineDiffCollapsesUnchangedLines(t *testing.T) {
+	var from, to strings.Builder
+
+	for i := 0; i < 20; i++ {
+		line := fmt.Sprintf("<p>%d</p>", i)
+
+		from.WriteString(line)
+
+		if i == 10 {
+			line = "<p>changed</p>"
+		}
+
+		to.WriteString(line)
+	}
+
+	want := "  … 7 unchanged lines\n" +
+		"  <p>7</p>\n  <p>8</p>\n  <p>9</p>\n" +
+		"- <p>10</p>\n+ <p>changed</p>\n" +
+		"  <p>11</p>\n  <p>12</p>\n  <p>13</p>\n" +
+		"  … 6 unchanged lines\n"
+
+	if got := lineDiff(from.String(), to.String()); got != want {
+		t.Errorf("lineDiff() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestLineDiffLargeInput(t *testing.T) {
+	from := strings.Repeat("<p>a</p>", 3000)
+	to := strings.Repeat("<p>b</p>", 3000)
+
+	got := lineDiff(from, to)
+
+	if strings.Count(got, "- <p>a</p>\n") != 3000 || strings.Count(got, "+ <p>b</p>\n") != 3000 {
+		t.Errorf("expected every line to be reported as replaced")
+	}
+}
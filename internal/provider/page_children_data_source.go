@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/william-powell/terraform-provider-confluence/internal/confluence"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &pageChildrenDataSource{}
+	_ datasource.DataSourceWithConfigure      = &pageChildrenDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &pageChildrenDataSource{}
+)
+
+// NewPageChildrenDataSource is a helper function to simplify the provider implementation.
+func NewPageChildrenDataSource() datasource.DataSource {
+	return &pageChildrenDataSource{}
+}
+
+// pageChildrenDataSource is the data source implementation.
+type pageChildrenDataSource struct {
+	client *confluence.Client
+}
+
+// pageChildrenDataSourceModel maps the data source schema data.
+type pageChildrenDataSourceModel struct {
+	PageId   types.Int64              `tfsdk:"page_id"`
+	Depth    types.Int64              `tfsdk:"depth"`
+	Children []pageChildrenChildModel `tfsdk:"children"`
+}
+
+// pageChildrenChildModel maps a single page below the page.
+type pageChildrenChildModel struct {
+	Id       types.Int64  `tfsdk:"id"`
+	Title    types.String `tfsdk:"title"`
+	ParentId types.Int64  `tfsdk:"parent_id"`
+	Depth    types.Int64  `tfsdk:"depth"`
+	Position types.Int64  `tfsdk:"position"`
+	Status   types.String `tfsdk:"status"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *pageChildrenDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*confluence.Client)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *pageChildrenDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_page_children"
+}
+
+// Schema defines the schema for the data source.
+func (d *pageChildrenDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch the pages below a page in the page tree.",
+		Attributes: map[string]schema.Attribute{
+			"page_id": schema.Int64Attribute{
+				Description: "Identifier for the Confluence page.",
+				Required:    true,
+			},
+			"depth": schema.Int64Attribute{
+				Description: "How many levels below the page to list. Defaults to 1, the direct children only.",
+				Optional:    true,
+			},
+			"children": schema.ListNestedAttribute{
+				Description: "The pages below the page. Each page is followed by its own children, and siblings are ordered by position, as in the Confluence page tree.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Identifier for the child page.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The title of the child page.",
+							Computed:    true,
+						},
+						"parent_id": schema.Int64Attribute{
+							Description: "The page directly above the child page.",
+							Computed:    true,
+						},
+						"depth": schema.Int64Attribute{
+							Description: "How many levels below page_id the child page is, 1 for a direct child.",
+							Computed:    true,
+						},
+						"position": schema.Int64Attribute{
+							Description: "The position of the child page among its siblings, lowest first.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the child page, e.g. current or archived.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures depth lists at least the direct children.
+func (d *pageChildrenDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var depth types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("depth"), &depth)...)
+
+	if !depth.IsNull() && !depth.IsUnknown() && depth.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("depth"),
+			"Invalid Depth",
+			"depth must be at least 1.",
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *pageChildrenDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read page children data source")
+	var state pageChildrenDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	depth := int64(1)
+
+	if !state.Depth.IsNull() {
+		depth = state.Depth.ValueInt64()
+	}
+
+	children, err := d.client.GetContentDescendants(ctx, state.PageId.ValueInt64(), int(depth))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Page Children",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Children = make([]pageChildrenChildModel, 0, len(children))
+
+	for _, child := range children {
+		state.Children = append(state.Children, pageChildrenChildModel{
+			Id:       types.Int64Value(child.Id),
+			Title:    types.StringValue(child.Title),
+			ParentId: types.Int64Value(child.ParentContentId),
+			Depth:    types.Int64Value(int64(child.Depth)),
+			Position: types.Int64Value(child.ChildPosition),
+			Status:   types.StringValue(child.Status),
+		})
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading page children data source", map[string]any{"success": true})
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPageChildrenDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "confluence_page" "parent" {
+  title = "Unit Test Children Parent"
+  parent_id = "33296"
+  body = "<p>Unit Test Children Parent</p>"
+}
+
+resource "confluence_page" "child" {
+  title = "Unit Test Children Child"
+  parent_id = confluence_page.parent.id
+  body = "<p>Unit Test Children Child</p>"
+}
+
+resource "confluence_page" "grandchild" {
+  title = "Unit Test Children Grandchild"
+  parent_id = confluence_page.child.id
+  body = "<p>Unit Test Children Grandchild</p>"
+}
+
+data "confluence_page_children" "test" {
+	page_id = confluence_page.parent.id
+	depth = 2
+
+	depends_on = [confluence_page.grandchild]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.confluence_page_children.test", "children.#", "2"),
+					resource.TestCheckResourceAttrPair("data.confluence_page_children.test", "children.0.id", "confluence_page.child", "id"),
+					resource.TestCheckResourceAttr("data.confluence_page_children.test", "children.0.depth", "1"),
+					resource.TestCheckResourceAttrPair("data.confluence_page_children.test", "children.1.parent_id", "confluence_page.child", "id"),
+					resource.TestCheckResourceAttr("data.confluence_page_children.test", "children.1.depth", "2"),
+				),
+			},
+		},
+	})
+}
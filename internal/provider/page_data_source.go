@@ -2,11 +2,11 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -15,8 +15,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &pageDataSource{}
-	_ datasource.DataSourceWithConfigure = &pageDataSource{}
+	_ datasource.DataSource                   = &pageDataSource{}
+	_ datasource.DataSourceWithConfigure      = &pageDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &pageDataSource{}
 )
 
 // NewItemDataSource is a helper function to simplify the provider implementation.
@@ -26,7 +27,7 @@ func NewPageDataSource() datasource.DataSource {
 
 // pageDataSource is the data source implementation.
 type pageDataSource struct {
-	clientConfig *confluence.Config
+	client *confluence.Client
 }
 
 // itemDataSourceModel maps the data source schema data.
@@ -37,8 +38,10 @@ type pageDataSourceModel struct {
 	VersionNumber    types.Int64  `tfsdk:"version_number"`
 	VersionCreatedAt types.String `tfsdk:"version_created_at"`
 	SpaceId          types.Int64  `tfsdk:"space_id"`
+	SpaceKey         types.String `tfsdk:"space_key"`
 	Body             types.String `tfsdk:"body"`
 	ParentId         types.Int64  `tfsdk:"parent_id"`
+	Properties       types.Map    `tfsdk:"properties"`
 }
 
 // Configure adds the provider configured client to the data source.
@@ -47,12 +50,12 @@ func (d *pageDataSource) Configure(ctx context.Context, req datasource.Configure
 		return
 	}
 
-	config, ok := req.ProviderData.(*confluence.Config)
+	client, ok := req.ProviderData.(*confluence.Client)
 	if !ok {
 		tflog.Error(ctx, "Unable to prepare client")
 		return
 	}
-	d.clientConfig = config
+	d.client = client
 
 }
 
@@ -64,14 +67,16 @@ func (d *pageDataSource) Metadata(_ context.Context, req datasource.MetadataRequ
 // Schema defines the schema for the data source.
 func (d *pageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetch a page.",
+		Description: "Fetch a page by its id, or by its title within a space.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
-				Description: "Identifier for this Confluence page.",
-				Required:    true,
+				Description: "Identifier for this Confluence page. Set either id, or title together with space_id or space_key.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"title": schema.StringAttribute{
-				Description: "The title for this Confluence page.",
+				Description: "The title for this Confluence page. Set with space_id or space_key to look the page up by title; exactly one current page must match.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"created_at": schema.StringAttribute{
@@ -87,29 +92,88 @@ func (d *pageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Computed:    true,
 			},
 			"space_id": schema.Int64Attribute{
-				Description: "The space key for this Confluence page.",
+				Description: "The space of this Confluence page. Set to look the page up by title within this space.",
+				Optional:    true,
 				Computed:    true,
 			},
+			"space_key": schema.StringAttribute{
+				Description: "The key of the space, e.g. ENG, to look the page up by title within.",
+				Optional:    true,
+			},
 			"body": schema.StringAttribute{
 				Description: "The body of the of the confluence page.",
 				Computed:    true,
 			},
 			"parent_id": schema.Int64Attribute{
-				Description: "The space key for this Confluence page.",
+				Description: "The parentId of this Confluence page. When looking the page up by title, set to only match pages directly under this parent.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"properties": schema.MapAttribute{
+				Description: "Every content property of this page, keyed by property key, with values as JSON strings. Use jsondecode() to read a value. Empty, with a warning, when the credentials cannot read the properties of the page.",
+				ElementType: types.StringType,
 				Computed:    true,
 			},
 		},
 	}
 }
 
+// ValidateConfig ensures the page is looked up either by id, or by title
+// within a single space.
+func (d *pageDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config pageDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Id.IsUnknown() || config.Title.IsUnknown() || config.SpaceId.IsUnknown() || config.SpaceKey.IsUnknown() || config.ParentId.IsUnknown() {
+		return
+	}
+
+	if !config.Id.IsNull() {
+		if !config.Title.IsNull() || !config.SpaceId.IsNull() || !config.SpaceKey.IsNull() || !config.ParentId.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Invalid Page Lookup",
+				"When id is set, title, space_id, space_key and parent_id must not be set.",
+			)
+		}
+		return
+	}
+
+	if config.Title.IsNull() || config.SpaceId.IsNull() == config.SpaceKey.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("title"),
+			"Invalid Page Lookup",
+			"Set either id, or title together with exactly one of space_id or space_key.",
+		)
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *pageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	tflog.Debug(ctx, "Preparing to read page data source")
 	var state pageDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	contentDetail, err := confluence.GetContentDetailById(*d.clientConfig, state.Id.ValueInt64())
+	var contentDetail confluence.ContentDetail
+	var err error
+
+	if state.Id.IsNull() {
+		contentDetail, err = d.client.GetContentDetailByTitle(ctx, confluence.ContentLookup{
+			Title:           state.Title.ValueString(),
+			SpaceId:         state.SpaceId.ValueInt64(),
+			SpaceKey:        state.SpaceKey.ValueString(),
+			ParentContentId: state.ParentId.ValueInt64(),
+		})
+	} else {
+		contentDetail, err = d.client.GetContentDetailById(ctx, state.Id.ValueInt64())
+	}
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -119,14 +183,32 @@ func (d *pageDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	if contentDetail.ResponseStatusCode != 200 {
+	contentProperties, err := d.client.GetContentProperties(ctx, contentDetail.Id)
+
+	// Reading the page should not require access to its properties.
+	if confluence.IsForbidden(err) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("properties"),
+			"Unable to Read Page Properties",
+			"The credentials cannot read the properties of this page, so properties is empty: "+err.Error(),
+		)
+		contentProperties, err = nil, nil
+	}
+
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to Read Page",
-			fmt.Sprintf("Status Code: %d", contentDetail.ResponseStatusCode),
+			"Unable to Read Page Properties",
+			err.Error(),
 		)
 		return
 	}
 
+	properties, diags := contentPropertiesMap(ctx, contentProperties)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Map response body to model
 	state = pageDataSourceModel{
 		Id:               types.Int64Value(contentDetail.Id),
@@ -135,8 +217,10 @@ func (d *pageDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		VersionNumber:    types.Int64Value(contentDetail.Version.Number),
 		VersionCreatedAt: types.StringValue(contentDetail.Version.CreatedAt.Format(time.RFC822)),
 		SpaceId:          types.Int64Value(contentDetail.SpaceId),
+		SpaceKey:         state.SpaceKey,
 		Body:             types.StringValue(contentDetail.Body.Storage.Value),
 		ParentId:         types.Int64Value(contentDetail.ParentContentId),
+		Properties:       properties,
 	}
 
 	// Set state
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -36,3 +37,84 @@ data "confluence_page" "test" {
 		},
 	})
 }
+
+func TestAccPageDataSource_byTitle(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "confluence_page" "test" {
+  title = "Unit Test Page By Title"
+  parent_id = "33296"
+  body = "<p>Unit Test Page</p>"
+}
+
+data "confluence_page" "test" {
+	title = confluence_page.test.title
+	space_id = confluence_page.test.space_id
+	parent_id = 33296
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.confluence_page.test", "id", "confluence_page.test", "id"),
+					resource.TestCheckResourceAttr("data.confluence_page.test", "body", "<p>Unit Test Page</p>"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPageDataSource_properties(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "confluence_page" "test" {
+  title = "Unit Test Page Properties"
+  parent_id = "33296"
+  body = "<p>Unit Test Page</p>"
+  properties = {
+    owner = jsonencode({ team = "platform" })
+  }
+}
+
+data "confluence_page" "test" {
+	id = confluence_page.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("confluence_page.test", "properties.owner", `{"team":"platform"}`),
+					resource.TestCheckResourceAttr("data.confluence_page.test", "properties.owner", `{"team":"platform"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPageDataSource_invalidLookup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "confluence_page" "test" {
+	title = "Unit Test Page"
+	space_id = 7
+	space_key = "ENG"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Page Lookup`),
+			},
+			{
+				Config: providerConfig + `
+data "confluence_page" "test" {
+	title = "Unit Test Page"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Page Lookup`),
+			},
+		},
+	})
+}
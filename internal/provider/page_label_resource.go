@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/william-powell/terraform-provider-confluence/internal/confluence"
+	confluencevalidators "github.com/william-powell/terraform-provider-confluence/internal/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &pageLabelResource{}
+	_ resource.ResourceWithConfigure   = &pageLabelResource{}
+	_ resource.ResourceWithImportState = &pageLabelResource{}
+)
+
+// NewPageLabelResource is a helper function to simplify the provider implementation.
+func NewPageLabelResource() resource.Resource {
+	return &pageLabelResource{}
+}
+
+// pageLabelResource is the resource implementation.
+type pageLabelResource struct {
+	client *confluence.Client
+}
+
+// pageLabelResourceModel maps the resource schema data.
+type pageLabelResourceModel struct {
+	Id     types.String `tfsdk:"id"`
+	PageId types.Int64  `tfsdk:"page_id"`
+	Label  types.String `tfsdk:"label"`
+}
+
+// pageLabelId joins a page id and label into the resource id.
+func pageLabelId(pageId int64, label string) string {
+	return fmt.Sprintf("%d/%s", pageId, label)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *pageLabelResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*confluence.Client)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *pageLabelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_page_label"
+}
+
+// Schema defines the schema for the resource.
+func (r *pageLabelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single label on a Confluence Page, leaving the rest of the page alone. Do not use on a confluence_page that sets labels, as the two will remove each other's labels.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier for this label, in the form `<page_id>/<label>`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"page_id": schema.Int64Attribute{
+				Description: "The id of the page to label. Changing the page moves the label to the new page.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "The label, e.g. runbook. Changing the label replaces it on the page.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					confluencevalidators.IsValidLabel(),
+				},
+			},
+		},
+	}
+}
+
+// ImportState imports a label from an id of the form <page_id>/<label>.
+func (r *pageLabelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	pageIdText, label, found := strings.Cut(req.ID, "/")
+
+	pageId, err := strconv.ParseInt(pageIdText, 10, 64)
+
+	if !found || label == "" || err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing page label",
+			fmt.Sprintf("Could not import page label %q, expected an id of the form <page_id>/<label>, e.g. 33296/runbook.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), pageLabelId(pageId, label))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("page_id"), pageId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("label"), label)...)
+}
+
+// Create a new resource.
+func (r *pageLabelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create page label resource")
+	// Retrieve values from plan
+	var plan pageLabelResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.AddContentLabels(ctx, plan.PageId.ValueInt64(), []string{plan.Label.ValueString()})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Add Page Label",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(pageLabelId(plan.PageId.ValueInt64(), plan.Label.ValueString()))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Created page label resource", map[string]any{"success": true})
+}
+
+// Read resource information.
+func (r *pageLabelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read page label resource")
+	// Get current state
+	var state pageLabelResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	labels, err := r.client.GetContentLabels(ctx, state.PageId.ValueInt64())
+
+	// The page is gone, and the label with it.
+	if confluence.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Page Labels",
+			err.Error(),
+		)
+		return
+	}
+
+	found := false
+
+	for _, label := range labels {
+		if label == state.Label.ValueString() {
+			found = true
+			break
+		}
+	}
+
+	// The label was removed outside of Terraform.
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Debug(ctx, "Finished reading page label resource", map[string]any{"success": true})
+}
+
+// Update is not expected to be called, every attribute requires replacement.
+func (r *pageLabelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan pageLabelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *pageLabelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete page label resource")
+	// Retrieve values from state
+	var state pageLabelResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveContentLabel(ctx, state.PageId.ValueInt64(), state.Label.ValueString())
+
+	// The page or the label is already gone, nothing left to delete.
+	if confluence.IsNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Remove Page Label",
+			err.Error(),
+		)
+		return
+	}
+	tflog.Debug(ctx, "Deleted page label resource", map[string]any{"success": true})
+}
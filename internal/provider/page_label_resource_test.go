@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPageLabelResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "confluence_page" "test" {
+  title = "Unit Test Label Page"
+  parent_id = "33296"
+  body = "<p>Unit Test Label Page</p>"
+}
+
+resource "confluence_page_label" "test" {
+  page_id = confluence_page.test.id
+  label = "generated"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("confluence_page_label.test", "label", "generated"),
+					resource.TestCheckResourceAttrSet("confluence_page_label.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "confluence_page_label.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
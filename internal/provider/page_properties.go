@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/william-powell/terraform-provider-confluence/internal/confluence"
+)
+
+// jsonEqual reports whether a and b encode the same JSON value, ignoring
+// formatting and the order of object keys.
+func jsonEqual(a string, b string) bool {
+	var aValue, bValue any
+
+	if json.Unmarshal([]byte(a), &aValue) != nil || json.Unmarshal([]byte(b), &bValue) != nil {
+		return a == b
+	}
+
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+// compactJson removes insignificant whitespace from a JSON value.
+func compactJson(value json.RawMessage) string {
+	var b bytes.Buffer
+
+	if err := json.Compact(&b, value); err != nil {
+		return string(value)
+	}
+
+	return b.String()
+}
+
+// propertyValues converts a properties map to Go.
+func propertyValues(ctx context.Context, properties types.Map) (map[string]string, diag.Diagnostics) {
+	values := map[string]string{}
+
+	if properties.IsNull() {
+		return values, nil
+	}
+
+	diags := properties.ElementsAs(ctx, &values, false)
+
+	return values, diags
+}
+
+// setProperties writes the properties in plan that differ from state and
+// deletes the ones removed from plan. Properties never managed by Terraform
+// are left alone.
+func (r *pageResource) setProperties(ctx context.Context, id int64, plan types.Map, state types.Map) diag.Diagnostics {
+	planned, diags := propertyValues(ctx, plan)
+	previous, stateDiags := propertyValues(ctx, state)
+	diags.Append(stateDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	set := map[string]json.RawMessage{}
+
+	for key, value := range planned {
+		if current, ok := previous[key]; !ok || !jsonEqual(current, value) {
+			set[key] = json.RawMessage(value)
+		}
+	}
+
+	var remove []string
+
+	for key := range previous {
+		if _, ok := planned[key]; !ok {
+			remove = append(remove, key)
+		}
+	}
+
+	if err := r.client.SetContentProperties(ctx, id, set, remove); err != nil {
+		diags.AddAttributeError(
+			path.Root("properties"),
+			"Unable to Update Page Properties",
+			err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// readProperties refreshes the values of the managed properties in current.
+// Values equal to the current JSON are kept as written in the configuration
+// and properties deleted outside of Terraform are dropped.
+func (r *pageResource) readProperties(ctx context.Context, id int64, current types.Map) (types.Map, diag.Diagnostics) {
+	values, diags := propertyValues(ctx, current)
+	if diags.HasError() {
+		return current, diags
+	}
+
+	properties, err := r.client.GetContentProperties(ctx, id)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("properties"),
+			"Unable to Read Page Properties",
+			err.Error(),
+		)
+		return current, diags
+	}
+
+	remote := make(map[string]json.RawMessage, len(properties))
+
+	for _, property := range properties {
+		remote[property.Key] = property.Value
+	}
+
+	refreshed := make(map[string]string, len(values))
+
+	for key, value := range values {
+		remoteValue, ok := remote[key]
+
+		if !ok {
+			continue
+		}
+
+		refreshed[key] = value
+
+		if !jsonEqual(value, string(remoteValue)) {
+			refreshed[key] = compactJson(remoteValue)
+		}
+	}
+
+	refreshedMap, mapDiags := types.MapValueFrom(ctx, types.StringType, refreshed)
+	diags.Append(mapDiags...)
+
+	return refreshedMap, diags
+}
+
+// contentPropertiesMap converts every property of a page to a map of JSON
+// strings.
+func contentPropertiesMap(ctx context.Context, properties []confluence.ContentProperty) (types.Map, diag.Diagnostics) {
+	values := make(map[string]string, len(properties))
+
+	for _, property := range properties {
+		values[property.Key] = compactJson(property.Value)
+	}
+
+	return types.MapValueFrom(ctx, types.StringType, values)
+}
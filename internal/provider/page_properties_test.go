@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/william-powell/terraform-provider-confluence/internal/confluence"
+)
+
+func TestSetPropertiesRemovesAttribute(t *testing.T) {
+	var deleted []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"results":[{"id":1,"key":"owner","value":"a"},{"id":2,"key":"team","value":"b"},{"id":3,"key":"system","value":"c"}]}`))
+		case http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	r := &pageResource{client: confluence.NewClient(confluence.NewConfig(server.URL, "user@example.com", "secret"))}
+
+	state, _ := types.MapValueFrom(context.Background(), types.StringType, map[string]string{"owner": `"a"`, "team": `"b"`})
+
+	if diags := r.setProperties(context.Background(), 5, types.MapNull(types.StringType), state); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	sort.Strings(deleted)
+
+	if len(deleted) != 2 || deleted[0] != "/wiki/api/v2/pages/5/properties/1" || deleted[1] != "/wiki/api/v2/pages/5/properties/2" {
+		t.Errorf("deleted = %q, want only the managed properties", deleted)
+	}
+}
+
+func TestJsonEqual(t *testing.T) {
+	if !jsonEqual(`{"a": 1, "b": [true]}`, `{"b":[true],"a":1}`) {
+		t.Error("expected formatting and key order to be ignored")
+	}
+
+	if jsonEqual(`{"a":1}`, `{"a":2}`) {
+		t.Error("expected different values to differ")
+	}
+}
@@ -2,15 +2,20 @@ package provider
 
 import (
 	"context"
-	"net/http"
+	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -21,9 +26,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &pageResource{}
-	_ resource.ResourceWithConfigure   = &pageResource{}
-	_ resource.ResourceWithImportState = &pageResource{}
+	_ resource.Resource                   = &pageResource{}
+	_ resource.ResourceWithConfigure      = &pageResource{}
+	_ resource.ResourceWithImportState    = &pageResource{}
+	_ resource.ResourceWithModifyPlan     = &pageResource{}
+	_ resource.ResourceWithValidateConfig = &pageResource{}
 )
 
 // NewItemResource is a helper function to simplify the provider implementation.
@@ -33,19 +40,82 @@ func NewPageResource() resource.Resource {
 
 // itemResource is the resource implementation.
 type pageResource struct {
-	clientConfig *confluence.Config
+	client *confluence.Client
 }
 
 // itemResourceModel maps the resource schema data.
 type pageResourceModel struct {
-	Id               types.Int64  `tfsdk:"id"`
-	Title            types.String `tfsdk:"title"`
-	Body             types.String `tfsdk:"body"`
-	ParentId         types.Int64  `tfsdk:"parent_id"`
-	SpaceId          types.Int64  `tfsdk:"space_id"`
-	CreatedAt        types.String `tfsdk:"created_at"`
-	VersionNumber    types.Int64  `tfsdk:"version_number"`
-	VersionCreatedAt types.String `tfsdk:"version_created_at"`
+	Id                 types.Int64  `tfsdk:"id"`
+	Title              types.String `tfsdk:"title"`
+	Body               types.String `tfsdk:"body"`
+	ParentId           types.Int64  `tfsdk:"parent_id"`
+	AfterId            types.Int64  `tfsdk:"after_id"`
+	SpaceId            types.Int64  `tfsdk:"space_id"`
+	SpaceKey           types.String `tfsdk:"space_key"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	VersionNumber      types.Int64  `tfsdk:"version_number"`
+	VersionCreatedAt   types.String `tfsdk:"version_created_at"`
+	ConflictPolicy     types.String `tfsdk:"conflict_policy"`
+	LastAppliedVersion types.Int64  `tfsdk:"last_applied_version"`
+	VersionMessage     types.String `tfsdk:"version_message"`
+	MinorEdit          types.Bool   `tfsdk:"minor_edit"`
+	Labels             types.Set    `tfsdk:"labels"`
+	Properties         types.Map    `tfsdk:"properties"`
+
+	VersionRetention *pageVersionRetentionModel `tfsdk:"version_retention"`
+	Restrictions     *pageRestrictionsModel     `tfsdk:"restrictions"`
+}
+
+// pageVersionRetentionModel maps the version_retention block.
+type pageVersionRetentionModel struct {
+	KeepAll       types.Bool   `tfsdk:"keep_all"`
+	KeepLast      types.Int64  `tfsdk:"keep_last"`
+	KeepNewerThan types.String `tfsdk:"keep_newer_than"`
+}
+
+const (
+	conflictPolicyOverwrite string = "overwrite"
+	conflictPolicyFail      string = "fail"
+)
+
+// setContentDetail maps a page returned by the API onto the model, leaving
+// configuration-only attributes untouched.
+func (m *pageResourceModel) setContentDetail(contentDetail confluence.ContentDetail) {
+	m.Id = types.Int64Value(contentDetail.Id)
+	m.Title = types.StringValue(contentDetail.Title)
+	m.Body = types.StringValue(contentDetail.Body.Storage.Value)
+	m.ParentId = types.Int64Null()
+	if contentDetail.ParentContentId != 0 {
+		m.ParentId = types.Int64Value(contentDetail.ParentContentId)
+	}
+	m.SpaceId = types.Int64Value(contentDetail.SpaceId)
+	m.CreatedAt = types.StringValue(contentDetail.CreatedAt.Format(time.RFC822))
+	m.VersionNumber = types.Int64Value(contentDetail.Version.Number)
+	m.VersionCreatedAt = types.StringValue(contentDetail.Version.CreatedAt.Format(time.RFC822))
+}
+
+// versionRetention converts the version_retention block to the client
+// representation. Without the block only the current version is kept.
+func (m *pageResourceModel) versionRetention() confluence.VersionRetention {
+	retention := m.VersionRetention
+
+	if retention == nil {
+		return confluence.VersionRetention{KeepLast: 1}
+	}
+
+	switch {
+	case retention.KeepAll.ValueBool():
+		return confluence.VersionRetention{KeepAll: true}
+	case !retention.KeepLast.IsNull():
+		return confluence.VersionRetention{KeepLast: retention.KeepLast.ValueInt64()}
+	case !retention.KeepNewerThan.IsNull():
+		// Validated by the schema.
+		keepNewerThan, _ := confluencevalidators.ParseDuration(retention.KeepNewerThan.ValueString())
+
+		return confluence.VersionRetention{KeepNewerThan: keepNewerThan}
+	}
+
+	return confluence.VersionRetention{KeepLast: 1}
 }
 
 // Configure adds the provider configured client to the resource.
@@ -54,12 +124,12 @@ func (r *pageResource) Configure(ctx context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	config, ok := req.ProviderData.(*confluence.Config)
+	client, ok := req.ProviderData.(*confluence.Client)
 	if !ok {
 		tflog.Error(ctx, "Unable to prepare client")
 		return
 	}
-	r.clientConfig = config
+	r.client = client
 
 }
 
@@ -71,7 +141,7 @@ func (r *pageResource) Metadata(_ context.Context, req resource.MetadataRequest,
 // Schema defines the schema for the resource.
 func (r *pageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a Confluence Page. The versions of the page will be constrained to one, eliminating the need to manage versions. Changing the parent id will delete the existing page, and create a new page. Modifications directly in the Confluence UI of content will be overwritten on next apply. Changes in location or parent through the Confluence UI will yield unreliable results.",
+		Description: "Manages a Confluence Page. By default the versions of the page will be constrained to one, eliminating the need to manage versions; use version_retention to keep page history. Changing the parent id moves the existing page, keeping its identifier and history. Modifications directly in the Confluence UI of content will be overwritten on next apply. Changes in location or parent through the Confluence UI will yield unreliable results.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Description: "Identifier for this page.",
@@ -81,11 +151,8 @@ func (r *pageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"title": schema.StringAttribute{
-				Description: "The title for this page.",
+				Description: "The title for this page. Changing the title renames the page in place.",
 				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"body": schema.StringAttribute{
 				Description: "The HTML body for this page",
@@ -95,15 +162,46 @@ func (r *pageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"parent_id": schema.Int64Attribute{
-				Description: "The parentId of this page.",
-				Required:    true,
+				Description: "The parentId of this page. Changing the parent moves the page in place. Exactly one of parent_id, space_id or space_key must be set; omit parent_id to create the page at the root of a space.",
+				Optional:    true,
 				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
+					int64planmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+							// A page cannot be moved back to the root of its space.
+							resp.RequiresReplace = !req.StateValue.IsNull() && req.ConfigValue.IsNull()
+						},
+						"Removing the parent of a page requires replacing it.",
+						"Removing the parent of a page requires replacing it.",
+					),
 				},
 			},
+			"after_id": schema.Int64Attribute{
+				Description: "The id of a sibling page under parent_id that this page is placed directly after. It must be a child of parent_id, or a root page of the same space when parent_id is unset. When unset, the page is appended as the last child of its parent whenever it is moved.",
+				Optional:    true,
+			},
 			"space_id": schema.Int64Attribute{
-				Description: "The space of the page",
+				Description: "The space of the page. Set to create the page at the root of the space; otherwise taken from the parent page. Changing a configured space_id creates a new page.",
+				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"space_key": schema.StringAttribute{
+				Description: "The key of the space, e.g. ENG, to create the page at the root of. Changing the space key creates a new page.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							// Imported pages have no space_key in state,
+							// ModifyPlan checks those against the space of
+							// the page instead.
+							resp.RequiresReplace = !req.StateValue.IsNull() && !req.ConfigValue.IsNull()
+						},
+						"Changing the space key of a page requires replacing it.",
+						"Changing the space key of a page requires replacing it.",
+					),
+				},
 			},
 			"created_at": schema.StringAttribute{
 				Description: "The creation date for this Confluence page.",
@@ -117,10 +215,209 @@ func (r *pageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "The creation date for this Confluence page version.",
 				Computed:    true,
 			},
+			"conflict_policy": schema.StringAttribute{
+				Description: "What to do when the page was modified outside of Terraform since it was last applied. `overwrite` (default) replaces the remote changes, `fail` refuses to update the page and reports what changed.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(conflictPolicyOverwrite),
+				Validators: []validator.String{
+					confluencevalidators.StringOneOf(conflictPolicyOverwrite, conflictPolicyFail),
+				},
+			},
+			"last_applied_version": schema.Int64Attribute{
+				Description: "The version number of this Confluence page the last time Terraform created or updated it, or found it matching the configuration.",
+				Computed:    true,
+			},
+			"version_message": schema.StringAttribute{
+				Description: "The message recorded in the page history for each version Terraform creates. This is a Go template; `{{.PageId}}`, `{{.Title}}`, `{{.Version}}` and `{{.Timestamp}}` describe the new version and `{{env \"GITHUB_SHA\"}}` reads an environment variable starting with CI_, GITHUB_ or TFC_, e.g. a commit SHA or run id. Variables that hold credentials, such as GITHUB_TOKEN, cannot be read.",
+				Optional:    true,
+			},
+			"minor_edit": schema.BoolAttribute{
+				Description: "Record updates as minor edits, which do not notify the page's watchers. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"labels": schema.SetAttribute{
+				Description: "The labels of this page, e.g. runbook. Labels added or removed outside of Terraform are reconciled on the next apply. When unset, the labels of the page are not managed.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					confluencevalidators.IsValidLabels(),
+				},
+			},
+			"properties": schema.MapAttribute{
+				Description: "Content properties of this page, keyed by property key, with values as JSON strings, e.g. from jsonencode(). Only the keys set here are managed; other properties of the page, including those set by Confluence, are left alone. Removing a key, or the whole attribute, deletes those properties from the page.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Map{
+					confluencevalidators.IsValidJsonValues(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"restrictions": pageRestrictionsBlock(),
+			"version_retention": schema.SingleNestedBlock{
+				Description: "Which previous versions of this page to keep after each update. Set exactly one attribute. Without this block only the current version is kept.",
+				Attributes: map[string]schema.Attribute{
+					"keep_all": schema.BoolAttribute{
+						Description: "Keep the full page history.",
+						Optional:    true,
+					},
+					"keep_last": schema.Int64Attribute{
+						Description: "Keep this many of the newest versions, including the current version.",
+						Optional:    true,
+					},
+					"keep_newer_than": schema.StringAttribute{
+						Description: "Keep versions created within this duration, e.g. `30d` or `12h`. The current version is always kept.",
+						Optional:    true,
+						Validators: []validator.String{
+							confluencevalidators.IsValidDuration(),
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// ValidateConfig checks the page placement and version_message template, and
+// ensures the version_retention block selects a single policy.
+func (r *pageResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var versionMessage types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("version_message"), &versionMessage)...)
+
+	if !versionMessage.IsNull() && !versionMessage.IsUnknown() {
+		if _, err := parseVersionMessage(versionMessage.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("version_message"),
+				"Invalid Version Message Template",
+				err.Error(),
+			)
+		}
+	}
+
+	var parentId, spaceId types.Int64
+	var spaceKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("parent_id"), &parentId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("space_id"), &spaceId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("space_key"), &spaceKey)...)
+
+	if !parentId.IsUnknown() && !spaceId.IsUnknown() && !spaceKey.IsUnknown() {
+		placements := 0
+
+		for _, isNull := range []bool{parentId.IsNull(), spaceId.IsNull(), spaceKey.IsNull()} {
+			if !isNull {
+				placements++
+			}
+		}
+
+		if placements != 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("parent_id"),
+				"Invalid Page Placement",
+				"Exactly one of parent_id, space_id or space_key must be set. Use parent_id for a child page, or space_id or space_key for a page at the root of a space.",
+			)
+		}
+	}
+
+	var retention *pageVersionRetentionModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("version_retention"), &retention)...)
+	if resp.Diagnostics.HasError() || retention == nil {
+		return
+	}
+
+	if retention.KeepAll.IsUnknown() || retention.KeepLast.IsUnknown() || retention.KeepNewerThan.IsUnknown() {
+		return
+	}
+
+	set := 0
+
+	if retention.KeepAll.ValueBool() {
+		set++
+	}
+
+	if !retention.KeepLast.IsNull() {
+		set++
+
+		if retention.KeepLast.ValueInt64() < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("version_retention").AtName("keep_last"),
+				"Invalid Version Retention",
+				"keep_last must be at least 1, the current version is always kept.",
+			)
+		}
+	}
+
+	if !retention.KeepNewerThan.IsNull() {
+		set++
+	}
+
+	if set != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("version_retention"),
+			"Invalid Version Retention",
+			"Exactly one of keep_all = true, keep_last or keep_newer_than must be set in version_retention.",
+		)
+	}
+}
+
+// ModifyPlan records the current version as applied when the page was changed
+// outside of Terraform but already matches the configuration, so merging the
+// remote changes into the configuration clears a conflict. It also decides
+// whether a configured space_key replaces an imported page.
+func (r *pageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The resource is being created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state pageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if version, ok := matchedRemoteVersion(plan, state); ok {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("last_applied_version"), version)...)
+	}
+
+	// Imported pages have no space_key in state, so only replace them when
+	// the configured key is not the space the page is in.
+	if state.SpaceKey.IsNull() && !plan.SpaceKey.IsNull() && !plan.SpaceKey.IsUnknown() {
+		space, err := r.client.GetSpaceById(ctx, state.SpaceId.ValueInt64())
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("space_key"),
+				"Unable to Read Space",
+				err.Error(),
+			)
+			return
+		}
+
+		if space.Key != plan.SpaceKey.ValueString() {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("space_key"))
+		}
+	}
+}
+
+// matchedRemoteVersion returns the current version of the page in state when
+// it is newer than the last applied version and its title and body equal the
+// planned ones.
+func matchedRemoteVersion(plan pageResourceModel, state pageResourceModel) (types.Int64, bool) {
+	if state.VersionNumber.IsNull() || state.VersionNumber.Equal(state.LastAppliedVersion) {
+		return types.Int64Null(), false
+	}
+
+	if !plan.Title.Equal(state.Title) || !plan.Body.Equal(state.Body) {
+		return types.Int64Null(), false
+	}
+
+	return state.VersionNumber, true
+}
+
 func (r *pageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	// If our ID was a string then we could do this
@@ -152,9 +449,13 @@ func (r *pageResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	title := plan.Title.ValueString()
 	body := plan.Body.ValueString()
-	parentId := plan.ParentId.ValueInt64()
+	placement := confluence.ContentPlacement{
+		ParentContentId: plan.ParentId.ValueInt64(),
+		SpaceId:         plan.SpaceId.ValueInt64(),
+		SpaceKey:        plan.SpaceKey.ValueString(),
+	}
 
-	newContentDetail, err := confluence.CreateNewPage(*r.clientConfig, parentId, title, body)
+	newContentDetail, err := r.client.CreateNewPage(ctx, placement, title, body)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -164,15 +465,52 @@ func (r *pageResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	// Save the page before the remaining steps, so a failure below leaves it
+	// tainted in state rather than orphaned in Confluence.
+	plan.setContentDetail(newContentDetail)
+	plan.LastAppliedVersion = types.Int64Value(newContentDetail.Version.Number)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.AfterId.IsNull() {
+		newContentDetail, err = r.movePage(ctx, newContentDetail.Id, plan)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Position Page",
+				"The page was created, but could not be placed after page "+plan.AfterId.String()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.Labels.IsNull() {
+		resp.Diagnostics.Append(r.setLabels(ctx, newContentDetail.Id, plan.Labels)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.Restrictions != nil {
+		resp.Diagnostics.Append(r.setRestrictions(ctx, newContentDetail.Id, plan.Restrictions)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.Properties.IsNull() {
+		resp.Diagnostics.Append(r.setProperties(ctx, newContentDetail.Id, plan.Properties, types.MapNull(types.StringType))...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Map response body to model
-	plan.Id = types.Int64Value(newContentDetail.Id)
-	plan.Body = types.StringValue(newContentDetail.Body.Storage.Value)
-	plan.ParentId = types.Int64Value(newContentDetail.ParentContentId)
-	plan.Title = types.StringValue(newContentDetail.Title)
-	plan.SpaceId = types.Int64Value(newContentDetail.SpaceId)
-	plan.CreatedAt = types.StringValue(newContentDetail.CreatedAt.Format(time.RFC822))
-	plan.VersionNumber = types.Int64Value(newContentDetail.Version.Number)
-	plan.VersionCreatedAt = types.StringValue(newContentDetail.Version.CreatedAt.Format(time.RFC822))
+	plan.setContentDetail(newContentDetail)
+	plan.LastAppliedVersion = types.Int64Value(newContentDetail.Version.Number)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -194,7 +532,13 @@ func (r *pageResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	contentDetail, err := confluence.GetContentDetailById(*r.clientConfig, state.Id.ValueInt64())
+	contentDetail, err := r.client.GetContentDetailById(ctx, state.Id.ValueInt64())
+
+	// Treat HTTP 404 Not Found status as a signal to remove/recreate resource
+	if confluence.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -204,30 +548,58 @@ func (r *pageResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	// Treat HTTP 404 Not Found status as a signal to remove/recreate resource
-	if contentDetail.ResponseStatusCode == http.StatusNotFound {
-		resp.State.RemoveResource(ctx)
-		return
+	// Map response body to model
+	state.setContentDetail(contentDetail)
+
+	if !state.Labels.IsNull() {
+		labels, labelDiags := r.readLabels(ctx, contentDetail.Id)
+		resp.Diagnostics.Append(labelDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Labels = labels
 	}
 
-	if contentDetail.ResponseStatusCode != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP error code received for page",
-			contentDetail.ResponseStatus,
-		)
-		return
+	if state.Restrictions != nil {
+		restrictions, err := r.client.GetContentRestrictions(ctx, contentDetail.Id)
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("restrictions"),
+				"Unable to Read Page Restrictions",
+				err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(state.Restrictions.setRestrictions(ctx, restrictions)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	// Map response body to model
-	state = pageResourceModel{
-		Id:               types.Int64Value(contentDetail.Id),
-		Title:            types.StringValue(contentDetail.Title),
-		Body:             types.StringValue(contentDetail.Body.Storage.Value),
-		ParentId:         types.Int64Value(contentDetail.ParentContentId),
-		SpaceId:          types.Int64Value(contentDetail.SpaceId),
-		CreatedAt:        types.StringValue(contentDetail.CreatedAt.Format(time.RFC822)),
-		VersionNumber:    types.Int64Value(contentDetail.Version.Number),
-		VersionCreatedAt: types.StringValue(contentDetail.Version.CreatedAt.Format(time.RFC822)),
+	if !state.Properties.IsNull() {
+		properties, propertyDiags := r.readProperties(ctx, contentDetail.Id, state.Properties)
+		resp.Diagnostics.Append(propertyDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Properties = properties
+	}
+
+	// Imported pages have no history with Terraform yet.
+	if state.ConflictPolicy.IsNull() {
+		state.ConflictPolicy = types.StringValue(conflictPolicyOverwrite)
+	}
+
+	if state.MinorEdit.IsNull() {
+		state.MinorEdit = types.BoolValue(false)
+	}
+
+	if state.LastAppliedVersion.IsNull() {
+		state.LastAppliedVersion = types.Int64Value(contentDetail.Version.Number)
 	}
 
 	// Set refreshed state
@@ -249,36 +621,158 @@ func (r *pageResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	var state pageResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id := plan.Id.ValueInt64()
-	body := plan.Body.ValueString()
 
-	contentDetail, err := confluence.UpdateContentById(*r.clientConfig, id, body, true)
+	contentChanged := !plan.Title.Equal(state.Title) || !plan.Body.Equal(state.Body)
+	moved := !plan.ParentId.Equal(state.ParentId) || !plan.AfterId.Equal(state.AfterId)
+
+	// Root pages only move when placed after a sibling.
+	if plan.ParentId.IsNull() && plan.AfterId.IsNull() {
+		moved = false
+	}
+
+	// Unknown unless ModifyPlan found the page already matches the
+	// configuration.
+	if plan.LastAppliedVersion.IsUnknown() {
+		plan.LastAppliedVersion = state.LastAppliedVersion
+	}
+
+	// applied tracks what has been written to Confluence so far. It is saved
+	// when a later step fails, so the completed steps are not lost.
+	applied := state
+
+	if contentChanged {
+		update := confluence.ContentUpdate{
+			Title:     plan.Title.ValueString(),
+			Body:      plan.Body.ValueString(),
+			MinorEdit: plan.MinorEdit.ValueBool(),
+		}
+
+		if !plan.VersionMessage.IsNull() {
+			message, err := renderVersionMessage(plan.VersionMessage.ValueString(), id, update.Title, state.VersionNumber.ValueInt64()+1)
+
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("version_message"),
+					"Unable to Render Version Message",
+					err.Error(),
+				)
+				return
+			}
+
+			update.VersionMessage = message
+		}
+
+		if plan.ConflictPolicy.ValueString() == conflictPolicyFail {
+			update.ExpectedVersion = state.LastAppliedVersion.ValueInt64()
+		}
+
+		_, err := r.client.UpdateContentById(ctx, id, update)
+
+		var conflictErr *confluence.VersionConflictError
+		if errors.As(err, &conflictErr) {
+			resp.Diagnostics.AddError(
+				"Page Modified Outside of Terraform",
+				versionConflictDetail(conflictErr),
+			)
+			return
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Page",
+				err.Error(),
+			)
+			return
+		}
+
+		// The page is already updated, so failing to prune its history
+		// should not fail the apply.
+		pruneResult, err := r.client.RemovePreviousVersions(ctx, id, plan.versionRetention())
+
+		tflog.Debug(ctx, "Removed previous page versions", map[string]any{"id": id, "deleted": pruneResult.Deleted})
+
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Unable to Remove Previous Page Versions",
+				fmt.Sprintf("The page was updated, but its previous versions could not all be removed (%d removed): %s", len(pruneResult.Deleted), err.Error()),
+			)
+		}
+	}
+
+	var contentDetail confluence.ContentDetail
+	var err error
+
+	if moved {
+		contentDetail, err = r.movePage(ctx, id, plan)
+	} else {
+		contentDetail, err = r.client.GetContentDetailById(ctx, id)
+	}
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Update Page",
-			err.Error(),
-		)
+		summary := "Unable to Read Page"
+		if moved {
+			summary = "Unable to Move Page"
+		}
+
+		resp.Diagnostics.AddError(summary, err.Error())
+
+		// The new content is already written. Its version number is not
+		// known, so leave last_applied_version for the next refresh to
+		// record.
+		if contentChanged {
+			applied.Title = plan.Title
+			applied.Body = plan.Body
+			applied.LastAppliedVersion = types.Int64Null()
+			resp.Diagnostics.Append(resp.State.Set(ctx, applied)...)
+		}
 		return
 	}
 
-	if contentDetail.ResponseStatusCode != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Unexpected HTTP error code received for Page",
-			contentDetail.ResponseStatus,
-		)
-		return
+	if contentChanged || moved {
+		plan.LastAppliedVersion = types.Int64Value(contentDetail.Version.Number)
+	}
+
+	plan.setContentDetail(contentDetail)
+
+	applied.setContentDetail(contentDetail)
+	applied.AfterId = plan.AfterId
+	applied.LastAppliedVersion = plan.LastAppliedVersion
+
+	if !plan.Labels.IsNull() && !plan.Labels.Equal(state.Labels) {
+		resp.Diagnostics.Append(r.setLabels(ctx, id, plan.Labels)...)
+		if resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.State.Set(ctx, applied)...)
+			return
+		}
 	}
 
-	plan = pageResourceModel{
-		Id:               types.Int64Value(contentDetail.Id),
-		Title:            types.StringValue(contentDetail.Title),
-		Body:             types.StringValue(contentDetail.Body.Storage.Value),
-		ParentId:         types.Int64Value(contentDetail.ParentContentId),
-		SpaceId:          types.Int64Value(contentDetail.SpaceId),
-		CreatedAt:        types.StringValue(contentDetail.CreatedAt.Format(time.RFC822)),
-		VersionNumber:    types.Int64Value(contentDetail.Version.Number),
-		VersionCreatedAt: types.StringValue(contentDetail.Version.CreatedAt.Format(time.RFC822)),
+	applied.Labels = plan.Labels
+
+	if plan.Restrictions != nil {
+		resp.Diagnostics.Append(r.setRestrictions(ctx, id, plan.Restrictions)...)
+		if resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.State.Set(ctx, applied)...)
+			return
+		}
+	}
+
+	applied.Restrictions = plan.Restrictions
+
+	// Removing the attribute deletes the properties it managed.
+	if !plan.Properties.Equal(state.Properties) {
+		resp.Diagnostics.Append(r.setProperties(ctx, id, plan.Properties, state.Properties)...)
+		if resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.State.Set(ctx, applied)...)
+			return
+		}
 	}
 
 	// Set refreshed state
@@ -301,7 +795,13 @@ func (r *pageResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 
 	//delete item
-	_, err := confluence.DeleteContentById(*r.clientConfig, state.Id.ValueInt64())
+	err := r.client.DeleteContentById(ctx, state.Id.ValueInt64())
+
+	// The page is already gone, nothing left to delete.
+	if confluence.IsNotFound(err) {
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Delete Page",
@@ -311,3 +811,137 @@ func (r *pageResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 	tflog.Debug(ctx, "Deleted page resource", map[string]any{"success": true})
 }
+
+// movePage places the page after plan's after_id when set, otherwise as the
+// last child of plan's parent_id, and returns the moved page.
+func (r *pageResource) movePage(ctx context.Context, id int64, plan pageResourceModel) (confluence.ContentDetail, error) {
+	position := confluence.MovePositionAppend
+	targetId := plan.ParentId.ValueInt64()
+
+	if !plan.AfterId.IsNull() {
+		position = confluence.MovePositionAfter
+		targetId = plan.AfterId.ValueInt64()
+
+		// Confluence places the page under the actual parent of after_id,
+		// which would leave parent_id out of step with the configuration.
+		sibling, err := r.client.GetContentDetailById(ctx, targetId)
+
+		if err != nil {
+			return confluence.ContentDetail{}, err
+		}
+
+		if sibling.ParentContentId != plan.ParentId.ValueInt64() {
+			if plan.ParentId.IsNull() {
+				return confluence.ContentDetail{}, fmt.Errorf("after_id %d is not at the root of the space, it must be a sibling of the page", targetId)
+			}
+
+			return confluence.ContentDetail{}, fmt.Errorf("after_id %d is not a child of parent_id %d, it is a child of page %d", targetId, plan.ParentId.ValueInt64(), sibling.ParentContentId)
+		}
+	}
+
+	err := r.client.MovePage(ctx, id, position, targetId)
+
+	if err != nil {
+		return confluence.ContentDetail{}, err
+	}
+
+	return r.client.GetContentDetailById(ctx, id)
+}
+
+// setLabels reconciles the labels of the page with labels.
+func (r *pageResource) setLabels(ctx context.Context, id int64, labels types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var names []string
+
+	diags.Append(labels.ElementsAs(ctx, &names, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := r.client.SetContentLabels(ctx, id, names); err != nil {
+		diags.AddAttributeError(
+			path.Root("labels"),
+			"Unable to Update Page Labels",
+			err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// setRestrictions replaces the restrictions of the page with restrictions.
+func (r *pageResource) setRestrictions(ctx context.Context, id int64, restrictions *pageRestrictionsModel) diag.Diagnostics {
+	contentRestrictions, diags := restrictions.restrictions(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := r.client.SetContentRestrictions(ctx, id, contentRestrictions); err != nil {
+		diags.AddAttributeError(
+			path.Root("restrictions"),
+			"Unable to Update Page Restrictions",
+			err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// readLabels returns the current labels of the page.
+func (r *pageResource) readLabels(ctx context.Context, id int64) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	names, err := r.client.GetContentLabels(ctx, id)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("labels"),
+			"Unable to Read Page Labels",
+			err.Error(),
+		)
+		return types.SetNull(types.StringType), diags
+	}
+
+	labels, setDiags := types.SetValueFrom(ctx, types.StringType, names)
+	diags.Append(setDiags...)
+
+	return labels, diags
+}
+
+// versionConflictDetail describes a conflicting remote edit, including a diff
+// of the body between the last applied version and the current version.
+func versionConflictDetail(conflictErr *confluence.VersionConflictError) string {
+	var b strings.Builder
+
+	current := conflictErr.Current
+
+	fmt.Fprintf(&b, "Page %d was last applied by Terraform at version %d but is now at version %d", conflictErr.ContentId, conflictErr.ExpectedVersion, current.Version.Number)
+
+	if current.Version.AuthorId != "" {
+		fmt.Fprintf(&b, ", last changed by account %s", current.Version.AuthorId)
+	}
+
+	fmt.Fprintf(&b, " at %s.\n", current.Version.CreatedAt.Format(time.RFC822))
+
+	if current.Version.Message != "" {
+		fmt.Fprintf(&b, "Version message: %s\n", current.Version.Message)
+	}
+
+	fmt.Fprintf(&b, "The update was not applied because conflict_policy is \"fail\". Merge the remote changes into the configuration, so the next plan records version %d as applied, or set conflict_policy to \"overwrite\" to replace them.\n\n", current.Version.Number)
+
+	if conflictErr.Expected == nil {
+		fmt.Fprintf(&b, "Version %d is no longer available, so the remote changes cannot be shown.", conflictErr.ExpectedVersion)
+		return b.String()
+	}
+
+	expected := conflictErr.Expected
+
+	if expected.Title != current.Title {
+		fmt.Fprintf(&b, "Title changed from %q to %q.\n\n", expected.Title, current.Title)
+	}
+
+	fmt.Fprintf(&b, "Body changes since version %d:\n", conflictErr.ExpectedVersion)
+	b.WriteString(lineDiff(expected.Body.Storage.Value, current.Body.Storage.Value))
+
+	return b.String()
+}
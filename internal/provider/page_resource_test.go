@@ -0,0 +1,420 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/william-powell/terraform-provider-confluence/internal/confluence"
+)
+
+func TestAccPageResource_importRootPage(t *testing.T) {
+	config := providerConfig + `
+resource "confluence_space" "test" {
+  key = "TFACCROOTPAGE"
+  name = "Unit Test Root Page Space"
+}
+
+resource "confluence_page" "test" {
+  title = "Unit Test Root Page"
+  space_key = confluence_space.test.key
+  body = "<p>Unit Test Root Page</p>"
+}
+`
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("confluence_page.test", "parent_id"),
+					testAccCaptureAttr("confluence_page.test", "id", &id),
+				),
+			},
+			{
+				ResourceName:            "confluence_page.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"space_key"},
+				ImportStatePersist:      true,
+			},
+			{
+				// The imported page has no space_key in state, applying the
+				// same configuration must keep the page.
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("confluence_page.test", "space_key", "TFACCROOTPAGE"),
+					resource.TestCheckResourceAttrPtr("confluence_page.test", "id", &id),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPageResource_labels(t *testing.T) {
+	config := func(labels string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "confluence_page" "test" {
+  title = "Unit Test Labels Page"
+  parent_id = "33296"
+  body = "<p>Unit Test Labels Page</p>"
+  %s
+}
+`, labels)
+	}
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`labels = ["runbook"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("confluence_page.test", "labels.#", "1"),
+					resource.TestCheckTypeSetElemAttr("confluence_page.test", "labels.*", "runbook"),
+					testAccCaptureAttr("confluence_page.test", "id", &id),
+				),
+			},
+			{
+				Config: config(`labels = ["runbook", "ops"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("confluence_page.test", "labels.#", "2"),
+					resource.TestCheckTypeSetElemAttr("confluence_page.test", "labels.*", "ops"),
+				),
+			},
+			{
+				// A label added outside of Terraform is removed again.
+				PreConfig: func() {
+					pageId, _ := strconv.ParseInt(id, 10, 64)
+
+					if err := testAccClient().AddContentLabels(context.Background(), pageId, []string{"drift"}); err != nil {
+						t.Fatalf("unable to add label: %s", err)
+					}
+				},
+				Config: config(`labels = ["runbook", "ops"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("confluence_page.test", "labels.#", "2"),
+					testAccCheckPageLabels(&id, "ops", "runbook"),
+				),
+			},
+			{
+				Config: config(`labels = ["ops"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("confluence_page.test", "labels.#", "1"),
+					resource.TestCheckTypeSetElemAttr("confluence_page.test", "labels.*", "ops"),
+				),
+			},
+			{
+				Config: config(`labels = []`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("confluence_page.test", "labels.#", "0"),
+				),
+			},
+			{
+				// Without the attribute the labels of the page are not
+				// managed, so a plan with no changes follows.
+				Config: config(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("confluence_page.test", "labels.#"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckPageLabels checks the labels of the page in Confluence.
+func testAccCheckPageLabels(id *string, want ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		pageId, err := strconv.ParseInt(*id, 10, 64)
+
+		if err != nil {
+			return err
+		}
+
+		labels, err := testAccClient().GetContentLabels(context.Background(), pageId)
+
+		if err != nil {
+			return err
+		}
+
+		if fmt.Sprint(labels) != fmt.Sprint(want) {
+			return fmt.Errorf("labels = %v, want %v", labels, want)
+		}
+
+		return nil
+	}
+}
+
+func TestAccPageResource_restrictions(t *testing.T) {
+	config := func(restrictions string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "confluence_page" "test" {
+  title = "Unit Test Restrictions Page"
+  parent_id = "33296"
+  body = "<p>Unit Test Restrictions Page</p>"
+
+  restrictions {
+    %s
+  }
+}
+`, restrictions)
+	}
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`read {
+      groups = ["confluence-users"]
+    }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("confluence_page.test", "restrictions.read.groups.#", "1"),
+					resource.TestCheckNoResourceAttr("confluence_page.test", "restrictions.update.groups.#"),
+					testAccCaptureAttr("confluence_page.test", "id", &id),
+					testAccCheckPageRestrictions(&id, confluence.ContentRestrictions{
+						Read: confluence.RestrictionSubjects{Groups: []string{"confluence-users"}},
+					}),
+				),
+			},
+			{
+				Config: config(`read {
+      groups = ["confluence-users", "site-admins"]
+    }
+
+    update {
+      groups = ["confluence-users"]
+    }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("confluence_page.test", "restrictions.read.groups.#", "2"),
+					resource.TestCheckResourceAttr("confluence_page.test", "restrictions.update.groups.#", "1"),
+					testAccCheckPageRestrictions(&id, confluence.ContentRestrictions{
+						Read:   confluence.RestrictionSubjects{Groups: []string{"confluence-users", "site-admins"}},
+						Update: confluence.RestrictionSubjects{Groups: []string{"confluence-users"}},
+					}),
+				),
+			},
+			{
+				Config: config(`read {
+      groups = ["confluence-users"]
+    }
+
+    update {
+      groups = ["confluence-users", "site-admins"]
+    }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("confluence_page.test", "restrictions.read.groups.#", "1"),
+					resource.TestCheckResourceAttr("confluence_page.test", "restrictions.update.groups.#", "2"),
+					testAccCheckPageRestrictions(&id, confluence.ContentRestrictions{
+						Read:   confluence.RestrictionSubjects{Groups: []string{"confluence-users"}},
+						Update: confluence.RestrictionSubjects{Groups: []string{"confluence-users", "site-admins"}},
+					}),
+				),
+			},
+			{
+				// Removing the update block lifts the edit restrictions.
+				Config: config(`read {
+      groups = ["confluence-users"]
+    }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("confluence_page.test", "restrictions.update.groups.#"),
+					testAccCheckPageRestrictions(&id, confluence.ContentRestrictions{
+						Read: confluence.RestrictionSubjects{Groups: []string{"confluence-users"}},
+					}),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckPageRestrictions checks the groups restricting the page in
+// Confluence.
+func testAccCheckPageRestrictions(id *string, want confluence.ContentRestrictions) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		pageId, err := strconv.ParseInt(*id, 10, 64)
+
+		if err != nil {
+			return err
+		}
+
+		restrictions, err := testAccClient().GetContentRestrictions(context.Background(), pageId)
+
+		if err != nil {
+			return err
+		}
+
+		if fmt.Sprint(restrictions.Read.Groups) != fmt.Sprint(want.Read.Groups) {
+			return fmt.Errorf("read groups = %v, want %v", restrictions.Read.Groups, want.Read.Groups)
+		}
+
+		if fmt.Sprint(restrictions.Update.Groups) != fmt.Sprint(want.Update.Groups) {
+			return fmt.Errorf("update groups = %v, want %v", restrictions.Update.Groups, want.Update.Groups)
+		}
+
+		return nil
+	}
+}
+
+// testAccCaptureAttr stores the value of an attribute for later steps.
+func testAccCaptureAttr(name string, key string, value *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return fmt.Errorf("resource %s not found", name)
+		}
+
+		*value = rs.Primary.Attributes[key]
+
+		return nil
+	}
+}
+
+func TestVersionConflictDetail(t *testing.T) {
+	conflictErr := &confluence.VersionConflictError{
+		ContentId:       12,
+		ExpectedVersion: 2,
+		Current: confluence.ContentDetail{
+			Title:   "Runbook v2",
+			Version: confluence.ContentDetailVersion{Number: 3, AuthorId: "abc", Message: "Fixed typo", CreatedAt: time.Date(2023, 6, 30, 0, 0, 0, 0, time.UTC)},
+			Body:    confluence.ContentOperationBody{Storage: confluence.ContentOperationBodyStorage{Value: "<p>a</p><p>new</p>"}},
+		},
+		Expected: &confluence.ContentDetail{
+			Title: "Runbook",
+			Body:  confluence.ContentOperationBody{Storage: confluence.ContentOperationBodyStorage{Value: "<p>a</p><p>old</p>"}},
+		},
+	}
+
+	detail := versionConflictDetail(conflictErr)
+
+	for _, want := range []string{
+		"Page 12 was last applied by Terraform at version 2 but is now at version 3, last changed by account abc",
+		"Version message: Fixed typo",
+		"records version 3 as applied",
+		`Title changed from "Runbook" to "Runbook v2".`,
+		"- <p>old</p>\n+ <p>new</p>\n",
+	} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("detail does not contain %q:\n%s", want, detail)
+		}
+	}
+
+	conflictErr.Expected = nil
+
+	if detail := versionConflictDetail(conflictErr); !strings.Contains(detail, "Version 2 is no longer available") {
+		t.Errorf("detail does not report the missing version:\n%s", detail)
+	}
+}
+
+func TestVersionConflictDetailFromClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected %s request after a conflict", r.Method)
+			return
+		}
+
+		if r.URL.Query().Get("version") == "2" {
+			_, _ = w.Write([]byte(`{"id":1,"title":"Runbook","version":{"number":2},"body":{"storage":{"value":"<p>old</p>"}}}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id":1,"title":"Runbook","version":{"number":3,"authorId":"abc"},"body":{"storage":{"value":"<p>new</p>"}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := confluence.NewClient(confluence.NewConfig(server.URL, "user@example.com", "secret"))
+
+	_, err := client.UpdateContentById(context.Background(), 1, confluence.ContentUpdate{Title: "Runbook", Body: "<p>mine</p>", ExpectedVersion: 2})
+
+	var conflictErr *confluence.VersionConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Fatalf("error = %v, want *VersionConflictError", err)
+	}
+
+	detail := versionConflictDetail(conflictErr)
+
+	if !strings.Contains(detail, "- <p>old</p>\n+ <p>new</p>\n") || strings.Contains(detail, "Title changed") {
+		t.Errorf("unexpected detail:\n%s", detail)
+	}
+}
+
+func TestMatchedRemoteVersion(t *testing.T) {
+	state := pageResourceModel{
+		Title:              types.StringValue("Runbook"),
+		Body:               types.StringValue("<p>merged</p>"),
+		VersionNumber:      types.Int64Value(3),
+		LastAppliedVersion: types.Int64Value(2),
+	}
+
+	tests := []struct {
+		name string
+		plan pageResourceModel
+		want types.Int64
+		ok   bool
+	}{
+		{
+			name: "configuration matches remote",
+			plan: pageResourceModel{Title: types.StringValue("Runbook"), Body: types.StringValue("<p>merged</p>")},
+			want: types.Int64Value(3),
+			ok:   true,
+		},
+		{
+			name: "configuration differs from remote",
+			plan: pageResourceModel{Title: types.StringValue("Runbook"), Body: types.StringValue("<p>mine</p>")},
+		},
+		{
+			name: "unknown body",
+			plan: pageResourceModel{Title: types.StringValue("Runbook"), Body: types.StringUnknown()},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := matchedRemoteVersion(test.plan, state)
+
+			if ok != test.ok || (ok && !got.Equal(test.want)) {
+				t.Errorf("matchedRemoteVersion() = %s, %t, want %s, %t", got, ok, test.want, test.ok)
+			}
+		})
+	}
+
+	state.LastAppliedVersion = types.Int64Value(3)
+
+	if _, ok := matchedRemoteVersion(tests[0].plan, state); ok {
+		t.Error("matchedRemoteVersion() reported a version that is already applied")
+	}
+}
+
+func TestMovePageChecksAfterIdParent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/wiki/api/v2/pages/34" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id":34,"parentId":99}`))
+	}))
+	t.Cleanup(server.Close)
+
+	r := &pageResource{client: confluence.NewClient(confluence.NewConfig(server.URL, "user@example.com", "secret"))}
+
+	_, err := r.movePage(context.Background(), 12, pageResourceModel{ParentId: types.Int64Value(56), AfterId: types.Int64Value(34)})
+
+	if err == nil || !strings.Contains(err.Error(), "after_id 34 is not a child of parent_id 56") {
+		t.Errorf("error = %v, want after_id to be refused", err)
+	}
+}
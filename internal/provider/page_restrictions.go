@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/william-powell/terraform-provider-confluence/internal/confluence"
+)
+
+// pageRestrictionsModel maps the restrictions block of a page.
+type pageRestrictionsModel struct {
+	Read   *pageRestrictionSubjectsModel `tfsdk:"read"`
+	Update *pageRestrictionSubjectsModel `tfsdk:"update"`
+}
+
+// pageRestrictionSubjectsModel maps the read and update blocks.
+type pageRestrictionSubjectsModel struct {
+	Users  types.Set `tfsdk:"users"`
+	Groups types.Set `tfsdk:"groups"`
+}
+
+// pageRestrictionsBlock defines the schema of the restrictions block.
+func pageRestrictionsBlock() schema.SingleNestedBlock {
+	subjects := func(operation string) schema.SingleNestedBlock {
+		return schema.SingleNestedBlock{
+			Description: "Who may " + operation + " this page. Without this block anyone with access to the space may " + operation + " it.",
+			Attributes: map[string]schema.Attribute{
+				"users": schema.SetAttribute{
+					Description: "The account ids of the users allowed to " + operation + " this page.",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"groups": schema.SetAttribute{
+					Description: "The names of the groups allowed to " + operation + " this page.",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+			},
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		Description: "Who may view and edit this page. Restrictions changed outside of Terraform are reconciled on the next apply. Without this block the restrictions of the page are not managed. Include the Terraform user, or one of its groups, or Terraform will lose access to the page.",
+		Blocks: map[string]schema.Block{
+			"read":   subjects("view"),
+			"update": subjects("edit"),
+		},
+	}
+}
+
+// restrictions converts the block to the client representation.
+func (m *pageRestrictionsModel) restrictions(ctx context.Context) (confluence.ContentRestrictions, diag.Diagnostics) {
+	var restrictions confluence.ContentRestrictions
+	var diags diag.Diagnostics
+
+	restrictions.Read, diags = m.Read.subjects(ctx)
+	if diags.HasError() {
+		return restrictions, diags
+	}
+
+	update, updateDiags := m.Update.subjects(ctx)
+	diags.Append(updateDiags...)
+	restrictions.Update = update
+
+	return restrictions, diags
+}
+
+// setRestrictions maps the restrictions returned by the API onto the block,
+// keeping unset blocks and attributes unset while they stay empty.
+func (m *pageRestrictionsModel) setRestrictions(ctx context.Context, restrictions confluence.ContentRestrictions) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Read, diags = m.Read.withSubjects(ctx, restrictions.Read)
+	if diags.HasError() {
+		return diags
+	}
+
+	update, updateDiags := m.Update.withSubjects(ctx, restrictions.Update)
+	diags.Append(updateDiags...)
+	m.Update = update
+
+	return diags
+}
+
+func (m *pageRestrictionSubjectsModel) subjects(ctx context.Context) (confluence.RestrictionSubjects, diag.Diagnostics) {
+	var subjects confluence.RestrictionSubjects
+	var diags diag.Diagnostics
+
+	if m == nil {
+		return subjects, diags
+	}
+
+	if !m.Users.IsNull() {
+		diags.Append(m.Users.ElementsAs(ctx, &subjects.Users, false)...)
+	}
+
+	if !m.Groups.IsNull() {
+		diags.Append(m.Groups.ElementsAs(ctx, &subjects.Groups, false)...)
+	}
+
+	return subjects, diags
+}
+
+func (m *pageRestrictionSubjectsModel) withSubjects(ctx context.Context, subjects confluence.RestrictionSubjects) (*pageRestrictionSubjectsModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m == nil {
+		if len(subjects.Users) == 0 && len(subjects.Groups) == 0 {
+			return nil, diags
+		}
+
+		m = &pageRestrictionSubjectsModel{
+			Users:  types.SetNull(types.StringType),
+			Groups: types.SetNull(types.StringType),
+		}
+	}
+
+	users, usersDiags := restrictionSet(ctx, m.Users, subjects.Users)
+	diags.Append(usersDiags...)
+
+	groups, groupsDiags := restrictionSet(ctx, m.Groups, subjects.Groups)
+	diags.Append(groupsDiags...)
+
+	return &pageRestrictionSubjectsModel{Users: users, Groups: groups}, diags
+}
+
+// restrictionSet converts values to a set, leaving an unset attribute unset
+// while there are no values.
+func restrictionSet(ctx context.Context, current types.Set, values []string) (types.Set, diag.Diagnostics) {
+	if len(values) == 0 {
+		if current.IsNull() {
+			return current, nil
+		}
+
+		values = []string{}
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, values)
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/william-powell/terraform-provider-confluence/internal/confluence"
+)
+
+func TestRestrictionSet(t *testing.T) {
+	groups := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("admins")})
+
+	tests := []struct {
+		name    string
+		current types.Set
+		values  []string
+		want    types.Set
+	}{
+		{
+			name:    "unset stays unset",
+			current: types.SetNull(types.StringType),
+			want:    types.SetNull(types.StringType),
+		},
+		{
+			name:    "empty list stays empty",
+			current: types.SetValueMust(types.StringType, []attr.Value{}),
+			want:    types.SetValueMust(types.StringType, []attr.Value{}),
+		},
+		{
+			name:    "removed remotely",
+			current: groups,
+			want:    types.SetValueMust(types.StringType, []attr.Value{}),
+		},
+		{
+			name:    "added remotely",
+			current: types.SetNull(types.StringType),
+			values:  []string{"admins"},
+			want:    groups,
+		},
+	}
+
+	for _, test := range tests {
+		got, diags := restrictionSet(context.Background(), test.current, test.values)
+
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected error: %v", test.name, diags)
+		}
+
+		if !got.Equal(test.want) {
+			t.Errorf("%s: got %s, want %s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestWithSubjects(t *testing.T) {
+	admins := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("admins")})
+	empty := types.SetValueMust(types.StringType, []attr.Value{})
+
+	tests := []struct {
+		name     string
+		current  *pageRestrictionSubjectsModel
+		subjects confluence.RestrictionSubjects
+		want     *pageRestrictionSubjectsModel
+	}{
+		{
+			name: "unset block stays unset",
+		},
+		{
+			name:     "unset block with remote-only subjects",
+			subjects: confluence.RestrictionSubjects{Groups: []string{"admins"}},
+			want:     &pageRestrictionSubjectsModel{Users: types.SetNull(types.StringType), Groups: admins},
+		},
+		{
+			name:    "empty lists stay empty",
+			current: &pageRestrictionSubjectsModel{Users: empty, Groups: empty},
+			want:    &pageRestrictionSubjectsModel{Users: empty, Groups: empty},
+		},
+		{
+			name:     "empty list with remote-only subjects",
+			current:  &pageRestrictionSubjectsModel{Users: types.SetNull(types.StringType), Groups: empty},
+			subjects: confluence.RestrictionSubjects{Groups: []string{"admins"}},
+			want:     &pageRestrictionSubjectsModel{Users: types.SetNull(types.StringType), Groups: admins},
+		},
+		{
+			name:    "subjects removed remotely",
+			current: &pageRestrictionSubjectsModel{Users: types.SetNull(types.StringType), Groups: admins},
+			want:    &pageRestrictionSubjectsModel{Users: types.SetNull(types.StringType), Groups: empty},
+		},
+	}
+
+	for _, test := range tests {
+		got, diags := test.current.withSubjects(context.Background(), test.subjects)
+
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected error: %v", test.name, diags)
+		}
+
+		if test.want == nil || got == nil {
+			if got != test.want {
+				t.Errorf("%s: got %+v, want %+v", test.name, got, test.want)
+			}
+
+			continue
+		}
+
+		if !got.Users.Equal(test.want.Users) || !got.Groups.Equal(test.want.Groups) {
+			t.Errorf("%s: got %+v, want %+v", test.name, *got, *test.want)
+		}
+	}
+}
+
+func TestSetRestrictionsKeepsUnsetBlocks(t *testing.T) {
+	model := pageRestrictionsModel{
+		Read: &pageRestrictionSubjectsModel{
+			Users:  types.SetNull(types.StringType),
+			Groups: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("users")}),
+		},
+	}
+
+	diags := model.setRestrictions(context.Background(), confluence.ContentRestrictions{
+		Read: confluence.RestrictionSubjects{Groups: []string{"users"}},
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if model.Update != nil {
+		t.Errorf("update = %+v, want unset", *model.Update)
+	}
+
+	if model.Read == nil || !model.Read.Users.IsNull() || len(model.Read.Groups.Elements()) != 1 {
+		t.Errorf("read = %+v, want only the configured group", model.Read)
+	}
+}
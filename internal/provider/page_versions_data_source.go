@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/william-powell/terraform-provider-confluence/internal/confluence"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &pageVersionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &pageVersionsDataSource{}
+)
+
+// NewPageVersionsDataSource is a helper function to simplify the provider implementation.
+func NewPageVersionsDataSource() datasource.DataSource {
+	return &pageVersionsDataSource{}
+}
+
+// pageVersionsDataSource is the data source implementation.
+type pageVersionsDataSource struct {
+	client *confluence.Client
+}
+
+// pageVersionsDataSourceModel maps the data source schema data.
+type pageVersionsDataSourceModel struct {
+	PageId   types.Int64                `tfsdk:"page_id"`
+	Versions []pageVersionsVersionModel `tfsdk:"versions"`
+}
+
+// pageVersionsVersionModel maps a single version of the page.
+type pageVersionsVersionModel struct {
+	Number    types.Int64  `tfsdk:"number"`
+	AuthorId  types.String `tfsdk:"author_id"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Message   types.String `tfsdk:"message"`
+	MinorEdit types.Bool   `tfsdk:"minor_edit"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *pageVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*confluence.Client)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+
+}
+
+// Metadata returns the data source type name.
+func (d *pageVersionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_page_versions"
+}
+
+// Schema defines the schema for the data source.
+func (d *pageVersionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch the version history of a page.",
+		Attributes: map[string]schema.Attribute{
+			"page_id": schema.Int64Attribute{
+				Description: "Identifier for the Confluence page.",
+				Required:    true,
+			},
+			"versions": schema.ListNestedAttribute{
+				Description: "Every version of the page, newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"number": schema.Int64Attribute{
+							Description: "The version number.",
+							Computed:    true,
+						},
+						"author_id": schema.StringAttribute{
+							Description: "The account id of the user who created this version.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The creation date for this version.",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "The message given when this version was created.",
+							Computed:    true,
+						},
+						"minor_edit": schema.BoolAttribute{
+							Description: "Whether this version was a minor edit that did not notify watchers.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *pageVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read page versions data source")
+	var state pageVersionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versions, err := d.client.GetContentVersions(ctx, state.PageId.ValueInt64())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Page Versions",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Versions = make([]pageVersionsVersionModel, 0, len(versions))
+
+	for _, version := range versions {
+		state.Versions = append(state.Versions, pageVersionsVersionModel{
+			Number:    types.Int64Value(version.Number),
+			AuthorId:  types.StringValue(version.AuthorId),
+			CreatedAt: types.StringValue(version.CreatedAt.Format(time.RFC822)),
+			Message:   types.StringValue(version.Message),
+			MinorEdit: types.BoolValue(version.MinorEdit),
+		})
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading page versions data source", map[string]any{"success": true})
+}
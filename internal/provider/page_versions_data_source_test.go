@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPageVersionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "confluence_page" "test" {
+  title = "Unit Test Versions Page"
+  parent_id = "33296"
+  body = "<p>Unit Test Versions Page</p>"
+}
+
+data "confluence_page_versions" "test" {
+	page_id = confluence_page.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.confluence_page_versions.test", "versions.#", "1"),
+					resource.TestCheckResourceAttr("data.confluence_page_versions.test", "versions.0.number", "1"),
+					resource.TestCheckResourceAttrSet("data.confluence_page_versions.test", "versions.0.author_id"),
+				),
+			},
+		},
+	})
+}
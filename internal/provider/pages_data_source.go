@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/william-powell/terraform-provider-confluence/internal/confluence"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &pagesDataSource{}
+	_ datasource.DataSourceWithConfigure = &pagesDataSource{}
+)
+
+// NewPagesDataSource is a helper function to simplify the provider implementation.
+func NewPagesDataSource() datasource.DataSource {
+	return &pagesDataSource{}
+}
+
+// pagesDataSource is the data source implementation.
+type pagesDataSource struct {
+	client *confluence.Client
+}
+
+// pagesDataSourceModel maps the data source schema data.
+type pagesDataSourceModel struct {
+	Cql   types.String         `tfsdk:"cql"`
+	Pages []pagesDataPageModel `tfsdk:"pages"`
+}
+
+// pagesDataPageModel maps a single page matched by the query.
+type pagesDataPageModel struct {
+	Id            types.Int64  `tfsdk:"id"`
+	Title         types.String `tfsdk:"title"`
+	SpaceId       types.Int64  `tfsdk:"space_id"`
+	SpaceKey      types.String `tfsdk:"space_key"`
+	ParentId      types.Int64  `tfsdk:"parent_id"`
+	VersionNumber types.Int64  `tfsdk:"version_number"`
+	Url           types.String `tfsdk:"url"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *pagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*confluence.Client)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *pagesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pages"
+}
+
+// Schema defines the schema for the data source.
+func (d *pagesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Search for pages with a CQL query.",
+		Attributes: map[string]schema.Attribute{
+			"cql": schema.StringAttribute{
+				Description: "The CQL query to run, e.g. `space = ENG and label = runbook`. Only pages are returned; other matching content such as blog posts is skipped.",
+				Required:    true,
+			},
+			"pages": schema.ListNestedAttribute{
+				Description: "Every page matching the query, in the order returned by the search.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Identifier for the page.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The title of the page.",
+							Computed:    true,
+						},
+						"space_id": schema.Int64Attribute{
+							Description: "The space of the page.",
+							Computed:    true,
+						},
+						"space_key": schema.StringAttribute{
+							Description: "The key of the space of the page.",
+							Computed:    true,
+						},
+						"parent_id": schema.Int64Attribute{
+							Description: "The parentId of the page, or 0 for a page at the root of its space.",
+							Computed:    true,
+						},
+						"version_number": schema.Int64Attribute{
+							Description: "The current version number of the page.",
+							Computed:    true,
+						},
+						"url": schema.StringAttribute{
+							Description: "The address of the page in the Confluence UI.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *pagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read pages data source")
+	var state pagesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results, err := d.client.SearchContent(ctx, state.Cql.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Search Pages",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Pages = make([]pagesDataPageModel, 0, len(results))
+
+	for _, result := range results {
+		if result.Type != "page" {
+			continue
+		}
+
+		state.Pages = append(state.Pages, pagesDataPageModel{
+			Id:            types.Int64Value(result.Id),
+			Title:         types.StringValue(result.Title),
+			SpaceId:       types.Int64Value(result.Space.Id),
+			SpaceKey:      types.StringValue(result.Space.Key),
+			ParentId:      types.Int64Value(result.ParentContentId()),
+			VersionNumber: types.Int64Value(result.Version.Number),
+			Url:           types.StringValue(result.Url),
+		})
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading pages data source", map[string]any{"success": true})
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPagesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "confluence_page" "test" {
+  title = "Unit Test Search Page"
+  parent_id = "33296"
+  body = "<p>Unit Test Search Page</p>"
+}
+
+data "confluence_pages" "test" {
+	cql = "id = ${confluence_page.test.id}"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.confluence_pages.test", "pages.#", "1"),
+					resource.TestCheckResourceAttrPair("data.confluence_pages.test", "pages.0.id", "confluence_page.test", "id"),
+					resource.TestCheckResourceAttr("data.confluence_pages.test", "pages.0.parent_id", "33296"),
+					resource.TestCheckResourceAttrSet("data.confluence_pages.test", "pages.0.url"),
+				),
+			},
+		},
+	})
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/william-powell/terraform-provider-confluence/internal/confluence"
 
@@ -39,9 +40,11 @@ type confluenceProvider struct {
 
 // confluenceProviderModel maps provider schema data to a Go type.
 type confluenceProviderModel struct {
-	BaseUrl  types.String `tfsdk:"base_url"`
-	Username types.String `tfsdk:"username"`
-	Apikey   types.String `tfsdk:"api_key"`
+	BaseUrl             types.String `tfsdk:"base_url"`
+	Username            types.String `tfsdk:"username"`
+	Apikey              types.String `tfsdk:"api_key"`
+	MaxRetries          types.Int64  `tfsdk:"max_retries"`
+	MaxRetryWaitSeconds types.Int64  `tfsdk:"max_retry_wait_seconds"`
 }
 
 // Metadata returns the provider type name.
@@ -66,6 +69,14 @@ func (p *confluenceProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:    true,
 				Description: "The apikey of the confluence cloud API credentials. May also be provided via the CONFLUENCE_API_KEY environment variable.",
 			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of times a request is retried after a rate limited (429) or transient gateway (502, 503, 504) response. Defaults to 4. Set to 0 to disable retries.",
+			},
+			"max_retry_wait_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of seconds to wait between retries, including waits requested by a Retry-After header. Defaults to 30.",
+			},
 		},
 		Blocks:      map[string]schema.Block{},
 		Description: "Interface with the Confluence Cloud service API.",
@@ -175,6 +186,33 @@ func (p *confluenceProvider) Configure(ctx context.Context, req provider.Configu
 		apikey = "unknown"
 	}
 
+	maxRetries := int64(confluence.DefaultMaxRetries)
+	maxRetryWait := confluence.DefaultMaxWait
+
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		maxRetries = config.MaxRetries.ValueInt64()
+	}
+
+	if !config.MaxRetryWaitSeconds.IsNull() && !config.MaxRetryWaitSeconds.IsUnknown() {
+		maxRetryWait = time.Duration(config.MaxRetryWaitSeconds.ValueInt64()) * time.Second
+	}
+
+	if maxRetries < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Invalid Confluence API max_retries",
+			"The provider max_retries value must be zero or greater.",
+		)
+	}
+
+	if maxRetryWait < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retry_wait_seconds"),
+			"Invalid Confluence API max_retry_wait_seconds",
+			"The provider max_retry_wait_seconds value must be zero or greater.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -182,11 +220,14 @@ func (p *confluenceProvider) Configure(ctx context.Context, req provider.Configu
 	tflog.Debug(ctx, "Creating Confluence client")
 
 	confluenceApiConfig := confluence.NewConfig(baseurl, username, apikey)
+	client := confluence.NewClient(confluenceApiConfig, confluence.WithRetry(int(maxRetries), maxRetryWait))
 
-	contentDetail, err := confluence.GetContentDetailById(*confluenceApiConfig, int64(1))
+	contentDetail, err := client.GetContentDetailById(ctx, int64(1))
 	_ = contentDetail
 
-	if err != nil {
+	// The probe page rarely exists; a 404 still proves the site and
+	// credentials are usable.
+	if err != nil && !confluence.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Unable to Create Confluence API Client",
 			"An unexpected error occurred when creating the Confluence API client. "+
@@ -198,8 +239,8 @@ func (p *confluenceProvider) Configure(ctx context.Context, req provider.Configu
 
 	// Make the Confluence client available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = confluenceApiConfig
-	resp.ResourceData = confluenceApiConfig
+	resp.DataSourceData = client
+	resp.ResourceData = client
 
 	tflog.Info(ctx, "Configured Confluence client", map[string]any{"success": true})
 }
@@ -207,13 +248,22 @@ func (p *confluenceProvider) Configure(ctx context.Context, req provider.Configu
 // DataSources defines the data sources implemented in the provider.
 func (p *confluenceProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAttachmentsDataSource,
 		NewPageDataSource,
+		NewPageChildrenDataSource,
+		NewPageVersionsDataSource,
+		NewPagesDataSource,
+		NewSpaceDataSource,
 	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *confluenceProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAttachmentResource,
 		NewPageResource,
+		NewPageLabelResource,
+		NewSpaceResource,
+		NewSpacePermissionResource,
 	}
 }
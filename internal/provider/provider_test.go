@@ -1,8 +1,11 @@
 package provider
 
 import (
+	"os"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/william-powell/terraform-provider-confluence/internal/confluence"
 )
 
 const (
@@ -31,3 +34,9 @@ var (
 		"confluence": providerserver.NewProtocol6WithError(New("test")()),
 	}
 )
+
+// testAccClient returns a client for changing Confluence outside of Terraform
+// during acceptance tests.
+func testAccClient() *confluence.Client {
+	return confluence.NewClient(confluence.NewConfig(os.Getenv("CONFLUENCE_BASE_URL"), os.Getenv("CONFLUENCE_USERNAME"), os.Getenv("CONFLUENCE_API_KEY")))
+}
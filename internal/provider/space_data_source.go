@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/william-powell/terraform-provider-confluence/internal/confluence"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &spaceDataSource{}
+	_ datasource.DataSourceWithConfigure      = &spaceDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &spaceDataSource{}
+)
+
+// NewSpaceDataSource is a helper function to simplify the provider implementation.
+func NewSpaceDataSource() datasource.DataSource {
+	return &spaceDataSource{}
+}
+
+// spaceDataSource is the data source implementation.
+type spaceDataSource struct {
+	client *confluence.Client
+}
+
+// spaceDataSourceModel maps the data source schema data.
+type spaceDataSourceModel struct {
+	Id         types.Int64  `tfsdk:"id"`
+	Key        types.String `tfsdk:"key"`
+	Name       types.String `tfsdk:"name"`
+	HomepageId types.Int64  `tfsdk:"homepage_id"`
+	Type       types.String `tfsdk:"type"`
+	Status     types.String `tfsdk:"status"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *spaceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*confluence.Client)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *spaceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space"
+}
+
+// Schema defines the schema for the data source.
+func (d *spaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch a space by its key or id.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "Identifier for this Confluence space. Exactly one of id or key must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "The key of this Confluence space, e.g. ENG. Exactly one of id or key must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of this Confluence space.",
+				Computed:    true,
+			},
+			"homepage_id": schema.Int64Attribute{
+				Description: "The id of the homepage of this Confluence space.",
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of this Confluence space, e.g. global or personal.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of this Confluence space, e.g. current or archived.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures the space is looked up by exactly one of id or key.
+func (d *spaceDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config spaceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Id.IsUnknown() || config.Key.IsUnknown() {
+		return
+	}
+
+	if config.Id.IsNull() == config.Key.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("key"),
+			"Invalid Space Lookup",
+			"Exactly one of id or key must be set.",
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *spaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read space data source")
+	var state spaceDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var space confluence.Space
+	var err error
+
+	if state.Key.IsNull() {
+		space, err = d.client.GetSpaceById(ctx, state.Id.ValueInt64())
+	} else {
+		space, err = d.client.GetSpaceByKey(ctx, state.Key.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Space",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state = spaceDataSourceModel{
+		Id:         types.Int64Value(space.Id),
+		Key:        types.StringValue(space.Key),
+		Name:       types.StringValue(space.Name),
+		HomepageId: types.Int64Value(space.HomepageId),
+		Type:       types.StringValue(space.Type),
+		Status:     types.StringValue(space.Status),
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading space data source", map[string]any{"success": true})
+}
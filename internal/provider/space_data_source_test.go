@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSpaceDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "confluence_space" "test" {
+  key = "TFACCDATA"
+  name = "Unit Test Data Space"
+}
+
+data "confluence_space" "by_key" {
+	key = confluence_space.test.key
+}
+
+data "confluence_space" "by_id" {
+	id = confluence_space.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.confluence_space.by_key", "id", "confluence_space.test", "id"),
+					resource.TestCheckResourceAttrPair("data.confluence_space.by_key", "homepage_id", "confluence_space.test", "homepage_id"),
+					resource.TestCheckResourceAttr("data.confluence_space.by_key", "status", "current"),
+					resource.TestCheckResourceAttr("data.confluence_space.by_id", "key", "TFACCDATA"),
+					resource.TestCheckResourceAttr("data.confluence_space.by_id", "name", "Unit Test Data Space"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,374 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/william-powell/terraform-provider-confluence/internal/confluence"
+	confluencevalidators "github.com/william-powell/terraform-provider-confluence/internal/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &spacePermissionResource{}
+	_ resource.ResourceWithConfigure      = &spacePermissionResource{}
+	_ resource.ResourceWithImportState    = &spacePermissionResource{}
+	_ resource.ResourceWithValidateConfig = &spacePermissionResource{}
+)
+
+// spacePermissionOperations are the permissions that can be granted in a
+// space, as <operation>:<target>.
+var spacePermissionOperations = []string{
+	"read:space",
+	"administer:space",
+	"export:space",
+	"restrict_content:space",
+	"delete:space",
+	"create:page",
+	"delete:page",
+	"archive:page",
+	"create:blogpost",
+	"delete:blogpost",
+	"create:comment",
+	"delete:comment",
+	"create:attachment",
+	"delete:attachment",
+}
+
+// NewSpacePermissionResource is a helper function to simplify the provider implementation.
+func NewSpacePermissionResource() resource.Resource {
+	return &spacePermissionResource{}
+}
+
+// spacePermissionResource is the resource implementation.
+type spacePermissionResource struct {
+	client *confluence.Client
+}
+
+// spacePermissionResourceModel maps the resource schema data.
+type spacePermissionResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	SpaceKey      types.String `tfsdk:"space_key"`
+	PrincipalType types.String `tfsdk:"principal_type"`
+	Principal     types.String `tfsdk:"principal"`
+	Permissions   types.Set    `tfsdk:"permissions"`
+}
+
+// subject converts the principal to the client representation.
+func (m *spacePermissionResourceModel) subject() confluence.SpacePermissionSubject {
+	return confluence.SpacePermissionSubject{
+		Type:       m.PrincipalType.ValueString(),
+		Identifier: m.Principal.ValueString(),
+	}
+}
+
+// operations converts the permissions to the client representation.
+func (m *spacePermissionResourceModel) operations(ctx context.Context) ([]confluence.SpacePermissionOperation, diag.Diagnostics) {
+	var permissions []string
+
+	diags := m.Permissions.ElementsAs(ctx, &permissions, false)
+
+	operations := make([]confluence.SpacePermissionOperation, 0, len(permissions))
+
+	for _, permission := range permissions {
+		key, target, _ := strings.Cut(permission, ":")
+		operations = append(operations, confluence.SpacePermissionOperation{Key: key, Target: target})
+	}
+
+	return operations, diags
+}
+
+// spacePermissionId joins the space key and principal into the resource id.
+func spacePermissionId(spaceKey string, principalType string, principal string) string {
+	return fmt.Sprintf("%s/%s/%s", spaceKey, principalType, principal)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *spacePermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*confluence.Client)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *spacePermissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space_permission"
+}
+
+// Schema defines the schema for the resource.
+func (r *spacePermissionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the permissions of a single user or group in a Confluence Space. Permissions granted to the principal outside of Terraform are revoked on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier for these permissions, in the form `<space_key>/<principal_type>/<principal>`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"space_key": schema.StringAttribute{
+				Description: "The key of the space, e.g. ENG.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_type": schema.StringAttribute{
+				Description: "Whether principal is a `user` or a `group`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					confluencevalidators.StringOneOf(confluence.SpacePermissionSubjectUser, confluence.SpacePermissionSubjectGroup),
+				},
+			},
+			"principal": schema.StringAttribute{
+				Description: "The account id of the user or the name of the group.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				Description: "The permissions granted, as `<operation>:<target>`: " + strings.Join(spacePermissionOperations, ", ") + ". Every other permission requires `read:space`.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures every permission is one Confluence knows and that
+// read:space is granted.
+func (r *spacePermissionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var permissions types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("permissions"), &permissions)...)
+	if resp.Diagnostics.HasError() || permissions.IsNull() || permissions.IsUnknown() {
+		return
+	}
+
+	known := make(map[string]bool, len(spacePermissionOperations))
+
+	for _, operation := range spacePermissionOperations {
+		known[operation] = true
+	}
+
+	hasRead := false
+
+	for _, element := range permissions.Elements() {
+		permission, ok := element.(types.String)
+
+		if !ok || permission.IsUnknown() {
+			return
+		}
+
+		if permission.ValueString() == "read:space" {
+			hasRead = true
+		}
+
+		if !known[permission.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("permissions").AtSetValue(element),
+				"Invalid Space Permission",
+				fmt.Sprintf("Got %q, permission must be one of: %s.", permission.ValueString(), strings.Join(spacePermissionOperations, ", ")),
+			)
+		}
+	}
+
+	if !hasRead {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("permissions"),
+			"Invalid Space Permission",
+			"permissions must include read:space, every other permission requires it.",
+		)
+	}
+}
+
+// ImportState imports permissions from an id of the form
+// <space_key>/<principal_type>/<principal>.
+func (r *spacePermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Group names may contain slashes, so the principal is everything after
+	// the second one.
+	parts := strings.SplitN(req.ID, "/", 3)
+
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" || (parts[1] != confluence.SpacePermissionSubjectUser && parts[1] != confluence.SpacePermissionSubjectGroup) {
+		resp.Diagnostics.AddError(
+			"Error importing space permission",
+			fmt.Sprintf("Could not import space permission %q, expected an id of the form <space_key>/<user|group>/<principal>, e.g. ENG/group/oncall.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space_key"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal_type"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal"), parts[2])...)
+}
+
+// Create a new resource.
+func (r *spacePermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create space permission resource")
+	// Retrieve values from plan
+	var plan spacePermissionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setPermissions(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(spacePermissionId(plan.SpaceKey.ValueString(), plan.PrincipalType.ValueString(), plan.Principal.ValueString()))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Created space permission resource", map[string]any{"success": true})
+}
+
+// Read resource information.
+func (r *spacePermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read space permission resource")
+	// Get current state
+	var state spacePermissionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, err := r.client.GetSpacePermissions(ctx, state.SpaceKey.ValueString(), state.subject())
+
+	// The space is gone, and its permissions with it.
+	if confluence.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Space Permissions",
+			err.Error(),
+		)
+		return
+	}
+
+	// Every permission of the principal was revoked outside of Terraform.
+	if len(permissions) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	operations := make([]string, 0, len(permissions))
+
+	for _, permission := range permissions {
+		operations = append(operations, permission.Operation.String())
+	}
+
+	state.Permissions, diags = types.SetValueFrom(ctx, types.StringType, operations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Finished reading space permission resource", map[string]any{"success": true})
+}
+
+func (r *spacePermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update space permission resource")
+	// Retrieve values from plan
+	var plan spacePermissionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setPermissions(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Updated space permission resource", map[string]any{"success": true})
+}
+
+func (r *spacePermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete space permission resource")
+	// Retrieve values from state
+	var state spacePermissionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetSpacePermissions(ctx, state.SpaceKey.ValueString(), state.subject(), nil)
+
+	// The space is already gone, nothing left to revoke.
+	if confluence.IsNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Revoke Space Permissions",
+			err.Error(),
+		)
+		return
+	}
+	tflog.Debug(ctx, "Deleted space permission resource", map[string]any{"success": true})
+}
+
+// setPermissions reconciles the permissions of the principal with plan.
+func (r *spacePermissionResource) setPermissions(ctx context.Context, plan spacePermissionResourceModel) diag.Diagnostics {
+	operations, diags := plan.operations(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := r.client.SetSpacePermissions(ctx, plan.SpaceKey.ValueString(), plan.subject(), operations); err != nil {
+		diags.AddError(
+			"Unable to Update Space Permissions",
+			err.Error(),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSpacePermissionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "confluence_space" "test" {
+  key = "TFACCPERM"
+  name = "Unit Test Permission Space"
+}
+
+resource "confluence_space_permission" "test" {
+  space_key = confluence_space.test.key
+  principal_type = "group"
+  principal = "confluence-users"
+  permissions = ["read:space", "create:page"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("confluence_space_permission.test", "id", "TFACCPERM/group/confluence-users"),
+					resource.TestCheckResourceAttr("confluence_space_permission.test", "permissions.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "confluence_space_permission.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: providerConfig + `
+resource "confluence_space" "test" {
+  key = "TFACCPERM"
+  name = "Unit Test Permission Space"
+}
+
+resource "confluence_space_permission" "test" {
+  space_key = confluence_space.test.key
+  principal_type = "group"
+  principal = "confluence-users"
+  permissions = ["read:space"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("confluence_space_permission.test", "permissions.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,293 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/william-powell/terraform-provider-confluence/internal/confluence"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &spaceResource{}
+	_ resource.ResourceWithConfigure   = &spaceResource{}
+	_ resource.ResourceWithImportState = &spaceResource{}
+)
+
+// NewSpaceResource is a helper function to simplify the provider implementation.
+func NewSpaceResource() resource.Resource {
+	return &spaceResource{}
+}
+
+// spaceResource is the resource implementation.
+type spaceResource struct {
+	client *confluence.Client
+}
+
+// spaceResourceModel maps the resource schema data.
+type spaceResourceModel struct {
+	Id          types.Int64  `tfsdk:"id"`
+	Key         types.String `tfsdk:"key"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+	HomepageId  types.Int64  `tfsdk:"homepage_id"`
+}
+
+// setSpace maps a space returned by the API onto the model.
+func (m *spaceResourceModel) setSpace(space confluence.Space) {
+	m.Id = types.Int64Value(space.Id)
+	m.Key = types.StringValue(space.Key)
+	m.Name = types.StringValue(space.Name)
+	m.Description = types.StringValue(space.Description.Plain.Value)
+	m.Type = types.StringValue(space.Type)
+	m.HomepageId = types.Int64Value(space.HomepageId)
+}
+
+// settings converts the model to the client representation.
+func (m *spaceResourceModel) settings() confluence.SpaceSettings {
+	return confluence.SpaceSettings{
+		Key:         m.Key.ValueString(),
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Type:        m.Type.ValueString(),
+		HomepageId:  m.HomepageId.ValueInt64(),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *spaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*confluence.Client)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *spaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space"
+}
+
+// Schema defines the schema for the resource.
+func (r *spaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Confluence Space. Deleting the space also deletes every page in it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "Identifier for this space.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "The key of this space, e.g. ENG. Changing the key creates a new space.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of this space.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The plain text description of this space.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of this space. Defaults to `global`. Changing the type creates a new space.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(confluence.SpaceTypeGlobal),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"homepage_id": schema.Int64Attribute{
+				Description: "The id of the homepage of this space. Confluence creates a homepage with every space; set to use another page of the space instead.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ImportState imports a space by its key.
+func (r *spaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+}
+
+// Create a new resource.
+func (r *spaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create space resource")
+	// Retrieve values from plan
+	var plan spaceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings := plan.settings()
+
+	space, err := r.client.CreateSpace(ctx, settings)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Space",
+			err.Error(),
+		)
+		return
+	}
+
+	// Save the space before replacing its homepage, so a failure below
+	// leaves it tainted in state rather than orphaned in Confluence.
+	plan.setSpace(space)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if settings.HomepageId != 0 {
+		space, err = r.client.UpdateSpace(ctx, settings.Key, settings)
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("homepage_id"),
+				"Unable to Set Space Homepage",
+				fmt.Sprintf("The space was created, but its homepage could not be set to page %d: %s", settings.HomepageId, err.Error()),
+			)
+			return
+		}
+	}
+
+	// Map response body to model
+	plan.setSpace(space)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Created space resource", map[string]any{"success": true})
+}
+
+// Read resource information.
+func (r *spaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read space resource")
+	// Get current state
+	var state spaceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	space, err := r.client.GetSpaceByKey(ctx, state.Key.ValueString())
+
+	// Treat a missing space as a signal to remove/recreate resource
+	if confluence.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Space",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.setSpace(space)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Finished reading space resource", map[string]any{"success": true})
+}
+
+func (r *spaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update space resource")
+	// Retrieve values from plan
+	var plan spaceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	space, err := r.client.UpdateSpace(ctx, plan.Key.ValueString(), plan.settings())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Space",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.setSpace(space)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Updated space resource", map[string]any{"success": true})
+}
+
+func (r *spaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete space resource")
+	// Retrieve values from state
+	var state spaceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSpace(ctx, state.Key.ValueString())
+
+	// The space is already gone, nothing left to delete.
+	if confluence.IsNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Space",
+			err.Error(),
+		)
+		return
+	}
+	tflog.Debug(ctx, "Deleted space resource", map[string]any{"success": true})
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSpaceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "confluence_space" "test" {
+  key = "TFACCSPACE"
+  name = "Unit Test Space"
+  description = "Created by the provider acceptance tests"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("confluence_space.test", "type", "global"),
+					resource.TestCheckResourceAttrSet("confluence_space.test", "id"),
+					resource.TestCheckResourceAttrSet("confluence_space.test", "homepage_id"),
+				),
+			},
+			{
+				ResourceName:      "confluence_space.test",
+				ImportState:       true,
+				ImportStateId:     "TFACCSPACE",
+				ImportStateVerify: true,
+			},
+			{
+				Config: providerConfig + `
+resource "confluence_space" "test" {
+  key = "TFACCSPACE"
+  name = "Unit Test Space Renamed"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("confluence_space.test", "name", "Unit Test Space Renamed"),
+					resource.TestCheckResourceAttr("confluence_space.test", "description", ""),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// versionMessageData is the data available to version_message templates.
+type versionMessageData struct {
+	PageId    int64
+	Title     string
+	Version   int64
+	Timestamp string
+}
+
+// versionMessageFuncs are the functions available to version_message
+// templates. env exposes variables such as GITHUB_SHA or TFC_RUN_ID.
+var versionMessageFuncs = template.FuncMap{
+	"env": versionMessageEnv,
+}
+
+var (
+	// versionMessageEnvPrefixes limit env to variables describing the CI
+	// run. Page history is visible to every reader of the page, so other
+	// variables could leak credentials.
+	versionMessageEnvPrefixes = []string{"CI_", "GITHUB_", "TFC_"}
+	// versionMessageEnvSecrets mark CI variables holding credentials, e.g.
+	// GITHUB_TOKEN or CI_REGISTRY_PASSWORD.
+	versionMessageEnvSecrets = []string{"TOKEN", "SECRET", "PASSWORD", "KEY", "CREDENTIAL"}
+)
+
+// versionMessageEnv reads an environment variable allowed in page history.
+func versionMessageEnv(name string) (string, error) {
+	allowed := false
+
+	for _, prefix := range versionMessageEnvPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			allowed = true
+			break
+		}
+	}
+
+	for _, secret := range versionMessageEnvSecrets {
+		if strings.Contains(strings.ToUpper(name), secret) {
+			allowed = false
+		}
+	}
+
+	if !allowed {
+		return "", fmt.Errorf("env %q is not allowed, only variables starting with %s that do not hold credentials can be read", name, strings.Join(versionMessageEnvPrefixes, ", "))
+	}
+
+	return os.Getenv(name), nil
+}
+
+func parseVersionMessage(text string) (*template.Template, error) {
+	return template.New("version_message").Funcs(versionMessageFuncs).Option("missingkey=error").Parse(text)
+}
+
+// renderVersionMessage expands a version_message template for the version
+// about to be created.
+func renderVersionMessage(text string, pageId int64, title string, version int64) (string, error) {
+	tmpl, err := parseVersionMessage(text)
+
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+
+	err = tmpl.Execute(&b, versionMessageData{
+		PageId:    pageId,
+		Title:     title,
+		Version:   version,
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(b.String()), nil
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderVersionMessage(t *testing.T) {
+	t.Setenv("GITHUB_SHA", "abc123")
+
+	message, err := renderVersionMessage(` Page {{.PageId}} "{{.Title}}" v{{.Version}} from {{env "GITHUB_SHA"}} `, 12, "Runbook", 4)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := `Page 12 "Runbook" v4 from abc123`; message != want {
+		t.Errorf("message = %q, want %q", message, want)
+	}
+}
+
+func TestRenderVersionMessageInvalidTemplate(t *testing.T) {
+	if _, err := parseVersionMessage("Deployed {{.Version"); err == nil {
+		t.Error("expected a parse error")
+	}
+
+	if _, err := renderVersionMessage("{{.Unknown}}", 12, "Runbook", 4); err == nil {
+		t.Error("expected an error for an unknown field")
+	}
+}
+
+func TestRenderVersionMessageBlocksEnv(t *testing.T) {
+	t.Setenv("CONFLUENCE_API_KEY", "secret")
+	t.Setenv("GITHUB_TOKEN", "secret")
+
+	for _, name := range []string{"CONFLUENCE_API_KEY", "GITHUB_TOKEN", "AWS_SECRET_ACCESS_KEY", "TF_VAR_password"} {
+		t.Run(name, func(t *testing.T) {
+			message, err := renderVersionMessage(`{{env "`+name+`"}}`, 12, "Runbook", 4)
+
+			if err == nil || !strings.Contains(err.Error(), "not allowed") {
+				t.Errorf("error = %v, want env to be refused", err)
+			}
+
+			if strings.Contains(message, "secret") {
+				t.Errorf("message = %q leaks the variable", message)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package confluencevalidators
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = durationValidator{}
+
+type durationValidator struct {
+}
+
+// Description describes the validation in plain text formatting.
+func (validator durationValidator) Description(_ context.Context) string {
+	return "string must be a positive duration such as 30d, 12h or 90m."
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator durationValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v durationValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := ParseDuration(request.ConfigValue.ValueString())
+
+	if err == nil && duration <= 0 {
+		err = strconv.ErrRange
+	}
+
+	if err != nil {
+		response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			request.Path,
+			"Invalid Duration Specified",
+			v.Description(ctx)+" "+err.Error()))
+	}
+}
+
+// ParseDuration parses a Go duration string, additionally accepting a whole
+// number of days such as "30d".
+func ParseDuration(value string) (time.Duration, error) {
+	if strings.HasSuffix(value, "d") {
+		count, err := strconv.ParseInt(strings.TrimSuffix(value, "d"), 10, 64)
+
+		if err != nil {
+			return 0, err
+		}
+
+		return time.Duration(count) * 24 * time.Hour, nil
+	}
+
+	return time.ParseDuration(value)
+}
+
+func IsValidDuration() validator.String {
+	return durationValidator{}
+}
@@ -0,0 +1,52 @@
+package confluencevalidators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.Map = jsonValuesValidator{}
+
+type jsonValuesValidator struct {
+}
+
+// Description describes the validation in plain text formatting.
+func (validator jsonValuesValidator) Description(_ context.Context) string {
+	return "values must be valid JSON, e.g. from jsonencode()."
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator jsonValuesValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v jsonValuesValidator) ValidateMap(ctx context.Context, request validator.MapRequest, response *validator.MapResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for key, element := range request.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		if !json.Valid([]byte(value.ValueString())) {
+			response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+				request.Path.AtMapKey(key),
+				"Invalid JSON Value",
+				fmt.Sprintf("The value of %q is not valid JSON, %s", key, v.Description(ctx))))
+		}
+	}
+}
+
+func IsValidJsonValues() validator.Map {
+	return jsonValuesValidator{}
+}
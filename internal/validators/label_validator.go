@@ -0,0 +1,111 @@
+package confluencevalidators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// labelInvalidCharacters are rejected by Confluence in label names.
+const labelInvalidCharacters = "!#&()*,.:;<>?@[]^"
+
+const labelMaxLength = 255
+
+var (
+	_ validator.String = labelValidator{}
+	_ validator.Set    = labelsValidator{}
+)
+
+type labelValidator struct {
+}
+
+// Description describes the validation in plain text formatting.
+func (validator labelValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("label must be lowercase, at most %d characters, and contain no whitespace or any of %s.", labelMaxLength, labelInvalidCharacters)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator labelValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v labelValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := labelError(request.ConfigValue.ValueString()); err != "" {
+		response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			request.Path,
+			"Invalid Label",
+			fmt.Sprintf("Label %q %s", request.ConfigValue.ValueString(), err)))
+	}
+}
+
+type labelsValidator struct {
+}
+
+// Description describes the validation in plain text formatting.
+func (validator labelsValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("labels must be lowercase, at most %d characters, and contain no whitespace or any of %s.", labelMaxLength, labelInvalidCharacters)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator labelsValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v labelsValidator) ValidateSet(ctx context.Context, request validator.SetRequest, response *validator.SetResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, element := range request.ConfigValue.Elements() {
+		label, ok := element.(types.String)
+
+		if !ok || label.IsNull() || label.IsUnknown() {
+			continue
+		}
+
+		if err := labelError(label.ValueString()); err != "" {
+			response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+				request.Path.AtSetValue(element),
+				"Invalid Label",
+				fmt.Sprintf("Label %q %s", label.ValueString(), err)))
+		}
+	}
+}
+
+// labelError describes why label would not be stored as given by Confluence,
+// or returns an empty string for a valid label.
+func labelError(label string) string {
+	switch {
+	case label == "":
+		return "must not be empty."
+	case len(label) > labelMaxLength:
+		return fmt.Sprintf("must be at most %d characters.", labelMaxLength)
+	case label != strings.ToLower(label):
+		return "must be lowercase, Confluence stores labels in lowercase."
+	case strings.IndexFunc(label, unicode.IsSpace) >= 0:
+		return "must not contain whitespace."
+	case strings.ContainsAny(label, labelInvalidCharacters):
+		return fmt.Sprintf("must not contain any of %s.", labelInvalidCharacters)
+	}
+
+	return ""
+}
+
+func IsValidLabel() validator.String {
+	return labelValidator{}
+}
+
+func IsValidLabels() validator.Set {
+	return labelsValidator{}
+}
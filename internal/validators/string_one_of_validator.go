@@ -0,0 +1,50 @@
+package confluencevalidators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = stringOneOfValidator{}
+
+type stringOneOfValidator struct {
+	values []string
+}
+
+// Description describes the validation in plain text formatting.
+func (validator stringOneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("string must be one of: %s.", strings.Join(validator.values, ", "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v stringOneOfValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	for _, allowed := range v.values {
+		if value == allowed {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+		request.Path,
+		"Invalid Value Specified",
+		fmt.Sprintf("Got %q, %s", value, v.Description(ctx))))
+}
+
+func StringOneOf(values ...string) validator.String {
+	return stringOneOfValidator{values: values}
+}